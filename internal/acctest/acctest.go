@@ -278,6 +278,29 @@ func PreCheck(ctx context.Context, t *testing.T) {
 	// Since we are outside the scope of the Terraform configuration we must
 	// call Configure() to properly initialize the provider configuration.
 	testAccProviderConfigure.Do(func() {
+		// Replaying recorded interactions doesn't require credentials.
+		// The provider instance used outside of the Terraform configuration makes no API calls,
+		// so account-dependent values (e.g. AccountID) are unavailable.
+		if isVCRReplaying() {
+			raw := map[string]any{
+				"region":                      Region(),
+				"skip_credentials_validation": true,
+				"skip_metadata_api_check":     "true",
+				"skip_requesting_account_id":  true,
+			}
+			if os.Getenv(envvar.Profile) == "" && os.Getenv(envvar.AccessKeyId) == "" && os.Getenv(envvar.ContainerCredentialsFullURI) == "" {
+				raw["access_key"] = vcrReplayAccessKeyID
+				raw["secret_key"] = vcrReplaySecretAccessKey
+			}
+
+			diags := Provider.Configure(ctx, terraformsdk.NewResourceConfigRaw(raw))
+			if err := sdkdiag.DiagnosticsError(diags); err != nil {
+				t.Fatalf("configuring provider: %s", err)
+			}
+
+			return
+		}
+
 		envvar.FailIfAllEmpty(t, []string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running acceptance testing")
 
 		if os.Getenv(envvar.AccessKeyId) != "" {
@@ -52,3 +52,31 @@ func TestRandomWithPrefix(t *testing.T) {
 		t.Errorf("REPLAYING: %s, RECORDING: %s", rep2, rec2)
 	}
 }
+
+func TestRandString(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	t.Setenv("VCR_PATH", t.TempDir())
+
+	t.Setenv("VCR_MODE", "RECORDING")
+	rec1 := acctest.RandString(t, 10)
+	rec2 := acctest.RandIntRange(t, 100, 200)
+	acctest.CloseVCRRecorder(ctx, t)
+
+	t.Setenv("VCR_MODE", "REPLAYING")
+	rep1 := acctest.RandString(t, 10)
+	rep2 := acctest.RandIntRange(t, 100, 200)
+
+	if rep1 != rec1 {
+		t.Errorf("REPLAYING: %s, RECORDING: %s", rep1, rec1)
+	}
+	if len(rep1) != 10 {
+		t.Errorf("length: %d", len(rep1))
+	}
+	if rep2 != rec2 {
+		t.Errorf("REPLAYING: %d, RECORDING: %d", rep2, rec2)
+	}
+	if rep2 < 100 || rep2 >= 200 {
+		t.Errorf("out of range: %d", rep2)
+	}
+}
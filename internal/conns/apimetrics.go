@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// APIMetricsFileEnvVar is the environment variable which enables the collection of AWS API call metrics.
+// Its value is the path of the JSON file that the summary of API calls is written to when the provider exits.
+const APIMetricsFileEnvVar = "TF_AWS_API_METRICS_FILE"
+
+// apiCallKey identifies the AWS API calls made on behalf of a single resource type and operation.
+type apiCallKey struct {
+	TypeName     string `json:"type_name"`
+	Operation    string `json:"operation"`
+	ServiceID    string `json:"service_id"`
+	APIOperation string `json:"api_operation"`
+}
+
+// apiCallSummary summarizes the AWS API calls made for a single resource type, operation and AWS API operation.
+type apiCallSummary struct {
+	apiCallKey
+	Calls           int     `json:"calls"`
+	Errors          int     `json:"errors"`
+	Retries         int     `json:"retries"`
+	TotalDurationMS float64 `json:"total_duration_ms"`
+	MaxDurationMS   float64 `json:"max_duration_ms"`
+}
+
+// apiMetricsSummary is the content of the API call metrics file.
+type apiMetricsSummary struct {
+	APICalls []apiCallSummary `json:"api_calls"`
+}
+
+type apiMetricsCollector struct {
+	mu    sync.Mutex
+	calls map[apiCallKey]*apiCallSummary
+}
+
+func newAPIMetricsCollector() *apiMetricsCollector {
+	return &apiMetricsCollector{
+		calls: make(map[apiCallKey]*apiCallSummary),
+	}
+}
+
+// apiMetrics collects metrics for all AWS API clients in the provider process.
+var apiMetrics = newAPIMetricsCollector()
+
+// addMiddleware adds the API call metrics middleware to an AWS SDK for Go v2 middleware stack.
+// The middleware runs once per API operation invocation, wrapping all attempts.
+func (c *apiMetricsCollector) addMiddleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("TerraformAPIMetrics", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		start := time.Now()
+		out, metadata, err := next.HandleInitialize(ctx, in)
+
+		var retries int
+		if v, ok := retry.GetAttemptResults(metadata); ok && len(v.Results) > 0 {
+			retries = len(v.Results) - 1
+		}
+
+		key := apiCallKey{
+			ServiceID:    awsmiddleware.GetServiceID(ctx),
+			APIOperation: awsmiddleware.GetOperationName(ctx),
+		}
+		if v, ok := FromContext(ctx); ok {
+			key.TypeName = v.TypeName
+			key.Operation = v.Operation
+		}
+
+		c.record(key, time.Since(start), retries, err)
+
+		return out, metadata, err
+	}), middleware.After)
+}
+
+func (c *apiMetricsCollector) record(key apiCallKey, duration time.Duration, retries int, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	v, ok := c.calls[key]
+	if !ok {
+		v = &apiCallSummary{apiCallKey: key}
+		c.calls[key] = v
+	}
+
+	ms := float64(duration) / float64(time.Millisecond)
+
+	v.Calls++
+	if err != nil {
+		v.Errors++
+	}
+	v.Retries += retries
+	v.TotalDurationMS += ms
+	v.MaxDurationMS = max(v.MaxDurationMS, ms)
+}
+
+// summary returns the collected metrics merged into an existing summary, slowest first.
+func (c *apiMetricsCollector) summary(existing apiMetricsSummary) apiMetricsSummary {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	calls := make(map[apiCallKey]*apiCallSummary)
+	for _, v := range existing.APICalls {
+		calls[v.apiCallKey] = &v
+	}
+	for k, v := range c.calls {
+		if w, ok := calls[k]; ok {
+			w.Calls += v.Calls
+			w.Errors += v.Errors
+			w.Retries += v.Retries
+			w.TotalDurationMS += v.TotalDurationMS
+			w.MaxDurationMS = max(w.MaxDurationMS, v.MaxDurationMS)
+		} else {
+			v := *v
+			calls[k] = &v
+		}
+	}
+
+	var summary apiMetricsSummary
+	for _, v := range calls {
+		summary.APICalls = append(summary.APICalls, *v)
+	}
+	slices.SortFunc(summary.APICalls, func(a, b apiCallSummary) int {
+		return cmp.Or(
+			cmp.Compare(b.TotalDurationMS, a.TotalDurationMS),
+			cmp.Compare(a.TypeName, b.TypeName),
+			cmp.Compare(a.Operation, b.Operation),
+			cmp.Compare(a.ServiceID, b.ServiceID),
+			cmp.Compare(a.APIOperation, b.APIOperation),
+		)
+	})
+
+	return summary
+}
+
+func apiMetricsEnabled() bool {
+	return os.Getenv(APIMetricsFileEnvVar) != ""
+}
+
+// WriteAPIMetrics writes a summary of the AWS API calls made by the provider to the file named by the TF_AWS_API_METRICS_FILE environment variable.
+// Terraform runs a separate provider process for each command, so metrics already in the file are merged with those collected by this process.
+// The file is locked while it is merged, as provider processes may run concurrently, e.g. in parallel acceptance tests.
+func WriteAPIMetrics(context.Context) error {
+	filename := os.Getenv(APIMetricsFileEnvVar)
+	if filename == "" {
+		return nil
+	}
+
+	return apiMetrics.write(filename)
+}
+
+// write merges the collected metrics into the specified file.
+func (c *apiMetricsCollector) write(filename string) error {
+	unlock, err := lockAPIMetricsFile(filename)
+	if err != nil {
+		return fmt.Errorf("locking API metrics file (%s): %w", filename, err)
+	}
+	defer unlock()
+
+	var existing apiMetricsSummary
+	if b, err := os.ReadFile(filename); err == nil {
+		if err := json.Unmarshal(b, &existing); err != nil {
+			return fmt.Errorf("reading API metrics file (%s): %w", filename, err)
+		}
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("reading API metrics file (%s): %w", filename, err)
+	}
+
+	b, err := json.MarshalIndent(c.summary(existing), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(filename, b, 0o600); err != nil {
+		return fmt.Errorf("writing API metrics file (%s): %w", filename, err)
+	}
+
+	return nil
+}
+
+const (
+	apiMetricsLockTimeout = 1 * time.Minute
+	// A lock file older than this was left by a process that exited while holding the lock.
+	// It must be shorter than apiMetricsLockTimeout so that a waiting process removes the stale lock before timing out.
+	apiMetricsLockStale = 30 * time.Second
+)
+
+// lockAPIMetricsFile acquires an exclusive lock on the API metrics file by creating a lock file alongside it.
+// The returned function releases the lock.
+func lockAPIMetricsFile(filename string) (func(), error) {
+	lockname := filename + ".lock"
+	deadline := time.Now().Add(apiMetricsLockTimeout)
+
+	for {
+		f, err := os.OpenFile(lockname, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+
+		if err == nil {
+			f.Close()
+
+			return func() {
+				os.Remove(lockname)
+			}, nil
+		}
+
+		if !errors.Is(err, fs.ErrExist) {
+			return nil, err
+		}
+
+		if fi, err := os.Stat(lockname); err == nil && time.Since(fi.ModTime()) > apiMetricsLockStale {
+			os.Remove(lockname)
+			continue
+		}
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timed out waiting for lock file (%s)", lockname)
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAPIMetricsMiddleware(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c := newAPIMetricsCollector()
+
+	invoke := func(ctx context.Context, err error) {
+		stack := middleware.NewStack("test", smithyhttp.NewStackRequest)
+		if err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{
+			ServiceID:     "Route 53",
+			OperationName: "ChangeResourceRecordSets",
+		}, middleware.Before); err != nil {
+			t.Fatal(err)
+		}
+		if err := c.addMiddleware(stack); err != nil {
+			t.Fatal(err)
+		}
+
+		handler := middleware.DecorateHandler(middleware.HandlerFunc(func(context.Context, any) (any, middleware.Metadata, error) {
+			return nil, middleware.Metadata{}, err
+		}), stack)
+		_, _, _ = handler.Handle(ctx, nil)
+	}
+
+	resourceCtx := NewOperationContext(NewResourceContext(ctx, "route53", "Record", "aws_route53_record"), "Create")
+	invoke(resourceCtx, nil)
+	invoke(resourceCtx, errors.New("failed"))
+	invoke(ctx, nil)
+
+	summary := c.summary(apiMetricsSummary{})
+	got := make(map[apiCallKey][2]int)
+	for _, v := range summary.APICalls {
+		got[v.apiCallKey] = [2]int{v.Calls, v.Errors}
+	}
+	want := map[apiCallKey][2]int{
+		{TypeName: "aws_route53_record", Operation: "Create", ServiceID: "Route 53", APIOperation: "ChangeResourceRecordSets"}: {2, 1},
+		{ServiceID: "Route 53", APIOperation: "ChangeResourceRecordSets"}:                                                      {1, 0},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
+
+func TestWriteAPIMetrics(t *testing.T) { //nolint:paralleltest // uses t.Setenv and the package-level collector
+	ctx := context.Background()
+	filename := filepath.Join(t.TempDir(), "metrics.json")
+	t.Setenv(APIMetricsFileEnvVar, filename)
+
+	key := apiCallKey{TypeName: "aws_organizations_account", Operation: "Read", ServiceID: "Organizations", APIOperation: "DescribeAccount"}
+	apiMetrics.record(key, 0, 2, nil)
+	t.Cleanup(func() {
+		apiMetrics = newAPIMetricsCollector()
+	})
+
+	// Each write merges the process's metrics into the existing file.
+	for range 2 {
+		if err := WriteAPIMetrics(ctx); err != nil {
+			t.Fatalf("writing API metrics: %s", err)
+		}
+	}
+
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var summary apiMetricsSummary
+	if err := json.Unmarshal(b, &summary); err != nil {
+		t.Fatal(err)
+	}
+
+	want := apiMetricsSummary{
+		APICalls: []apiCallSummary{
+			{apiCallKey: key, Calls: 2, Retries: 4},
+		},
+	}
+
+	if diff := cmp.Diff(summary, want, cmp.AllowUnexported(apiCallSummary{})); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
+
+func TestWriteAPIMetricsConcurrent(t *testing.T) {
+	t.Parallel()
+
+	filename := filepath.Join(t.TempDir(), "metrics.json")
+	key := apiCallKey{ServiceID: "EC2", APIOperation: "DescribeVpcs"}
+	const n = 10
+
+	// Each collector stands in for a separate provider process.
+	var wg sync.WaitGroup
+	errs := make(chan error, n)
+	for range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			c := newAPIMetricsCollector()
+			c.record(key, 0, 0, nil)
+			errs <- c.write(filename)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("writing API metrics: %s", err)
+		}
+	}
+
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var summary apiMetricsSummary
+	if err := json.Unmarshal(b, &summary); err != nil {
+		t.Fatal(err)
+	}
+
+	want := apiMetricsSummary{
+		APICalls: []apiCallSummary{
+			{apiCallKey: key, Calls: n},
+		},
+	}
+
+	if diff := cmp.Diff(summary, want, cmp.AllowUnexported(apiCallSummary{})); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
+
+func TestWriteAPIMetricsStaleLock(t *testing.T) {
+	t.Parallel()
+
+	filename := filepath.Join(t.TempDir(), "metrics.json")
+	lockname := filename + ".lock"
+
+	// A lock file left by a process that exited while holding the lock.
+	if err := os.WriteFile(lockname, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	modTime := time.Now().Add(-2 * apiMetricsLockStale)
+	if err := os.Chtimes(lockname, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+
+	c := newAPIMetricsCollector()
+	c.record(apiCallKey{ServiceID: "EC2", APIOperation: "DescribeVpcs"}, 0, 0, nil)
+
+	start := time.Now()
+	if err := c.write(filename); err != nil {
+		t.Fatalf("writing API metrics: %s", err)
+	}
+
+	if elapsed := time.Since(start); elapsed >= apiMetricsLockTimeout {
+		t.Errorf("writing API metrics took %s, want less than %s", elapsed, apiMetricsLockTimeout)
+	}
+
+	if _, err := os.Stat(lockname); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("lock file (%s) not removed: %v", lockname, err)
+	}
+}
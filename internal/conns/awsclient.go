@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	apigatewayv2_types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
@@ -31,20 +32,23 @@ type AWSClient struct {
 	ignoreTagsConfig  *tftags.IgnoreConfig
 	Region            string
 	ServicePackages   map[string]ServicePackage
-
-	awsConfig                 *aws.Config
-	clients                   map[string]any
-	conns                     map[string]any
-	endpoints                 map[string]string // From provider configuration.
-	httpClient                *http.Client
-	lock                      sync.Mutex
-	logger                    baselogging.Logger
-	partition                 endpoints.Partition
-	session                   *session_sdkv1.Session
-	s3ExpressClient           *s3.Client
-	s3UsePathStyle            bool   // From provider configuration.
-	s3USEast1RegionalEndpoint string // From provider configuration.
-	stsRegion                 string // From provider configuration.
+	tagPolicyConfig   *tftags.PolicyConfig
+
+	awsConfig                  *aws.Config
+	clients                    map[string]any
+	conns                      map[string]any
+	endpoints                  map[string]string // From provider configuration.
+	httpClient                 *http.Client
+	lock                       sync.Mutex
+	logger                     baselogging.Logger
+	partition                  endpoints.Partition
+	resourceTaggingAPIFallback bool                   // From provider configuration.
+	retryPolicies              map[string]RetryPolicy // From provider configuration.
+	session                    *session_sdkv1.Session
+	s3ExpressClient            *s3.Client
+	s3UsePathStyle             bool   // From provider configuration.
+	s3USEast1RegionalEndpoint  string // From provider configuration.
+	stsRegion                  string // From provider configuration.
 }
 
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
@@ -55,7 +59,11 @@ func (c *AWSClient) CredentialsProvider(context.Context) aws.CredentialsProvider
 	return c.awsConfig.Credentials
 }
 
-func (c *AWSClient) DefaultTagsConfig(context.Context) *tftags.DefaultConfig {
+func (c *AWSClient) DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig {
+	if v, ok := FromContext(ctx); ok {
+		return c.defaultTagsConfig.ForResource(v.ServicePackageName, v.TypeName)
+	}
+
 	return c.defaultTagsConfig
 }
 
@@ -63,6 +71,83 @@ func (c *AWSClient) IgnoreTagsConfig(context.Context) *tftags.IgnoreConfig {
 	return c.ignoreTagsConfig
 }
 
+func (c *AWSClient) TagPolicyConfig(context.Context) *tftags.PolicyConfig {
+	return c.tagPolicyConfig
+}
+
+// TaggingServicePackage returns the service package whose ListTags and UpdateTags methods are used for the resource with the specified identifier in the specified service package.
+// It is only used for resources registered with transparent tagging, i.e. those which declare tags but whose service package may have no tag methods;
+// resources without tags arguments are not tagged.
+// If the provider is configured to fall back to the Resource Groups Tagging API, the service package has no tag methods and the identifier is an ARN,
+// the Resource Groups Tagging API service package is returned and the second return value is true.
+func (c *AWSClient) TaggingServicePackage(_ context.Context, sp ServicePackage, identifier string) (ServicePackage, bool) {
+	if !c.resourceTaggingAPIFallback || !arn.IsARN(identifier) {
+		return sp, false
+	}
+
+	if hasTagMethods(sp) {
+		return sp, false
+	}
+
+	v, ok := c.ServicePackages[names.ResourceGroupsTaggingAPI]
+	if !ok {
+		return sp, false
+	}
+
+	return v, true
+}
+
+// SupportsTagging returns whether the tags of resources in the specified service package can be listed and updated,
+// either by the service package's tag methods or, if the provider is configured to fall back to it, by the Resource Groups Tagging API.
+func (c *AWSClient) SupportsTagging(_ context.Context, sp ServicePackage) bool {
+	return hasTagMethods(sp) || c.resourceTaggingAPIFallback
+}
+
+func hasTagMethods(sp ServicePackage) bool {
+	switch sp.(type) {
+	case tftags.ServiceTagLister, tftags.ResourceTypeTagLister, tftags.ServiceTagUpdater, tftags.ResourceTypeTagUpdater:
+		return true
+	}
+
+	return false
+}
+
+// SetCloudFormationStackTagsPropagated adds the tags of the CloudFormation stack that created a resource, identified by the
+// aws:cloudformation:stack-id tag in the resource's tags, to the tags propagated to the resource in Context.
+// The stack's tags are only read if the provider is configured to ignore propagated tags.
+func (c *AWSClient) SetCloudFormationStackTagsPropagated(ctx context.Context, tags tftags.KeyValueTags) error {
+	inContext, ok := tftags.FromContext(ctx)
+	if !ok || !inContext.IgnorePropagated() {
+		return nil
+	}
+
+	stackID := tags.KeyValue("aws:cloudformation:stack-id")
+	if stackID == nil {
+		return nil
+	}
+
+	input := &cloudformation.DescribeStacksInput{
+		StackName: stackID,
+	}
+
+	output, err := c.CloudFormationClient(ctx).DescribeStacks(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("reading CloudFormation Stack (%s): %w", aws.ToString(stackID), err)
+	}
+
+	for _, stack := range output.Stacks {
+		propagated := make(map[string]string, len(stack.Tags))
+		for _, v := range stack.Tags {
+			propagated[aws.ToString(v.Key)] = aws.ToString(v.Value)
+		}
+
+		inContext.AddTagsPropagated(tftags.New(ctx, propagated))
+	}
+
+	return nil
+}
+
 func (c *AWSClient) AwsConfig(context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
 	return c.awsConfig.Copy()
 }
@@ -280,8 +365,15 @@ func convertIPToDashIP(ip string) string {
 
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
+	cfg := c.awsConfig
+	if policy, ok := c.retryPolicies[servicePackageName]; ok && cfg != nil {
+		v := cfg.Copy()
+		v.Retryer = policy.retryer(v.Retryer)
+		cfg = &v
+	}
+
 	m := map[string]any{
-		"aws_sdkv2_config": c.awsConfig,
+		"aws_sdkv2_config": cfg,
 		"endpoint":         c.endpoints[servicePackageName],
 		"partition":        c.Partition(ctx),
 	}
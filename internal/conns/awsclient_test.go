@@ -8,6 +8,8 @@ import (
 	"testing"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 var (
@@ -230,3 +232,151 @@ func TestReverseDNS(t *testing.T) {
 		})
 	}
 }
+
+type testServicePackage struct {
+	name string
+}
+
+func (p *testServicePackage) FrameworkDataSources(context.Context) []*types.ServicePackageFrameworkDataSource {
+	return nil
+}
+
+func (p *testServicePackage) FrameworkResources(context.Context) []*types.ServicePackageFrameworkResource {
+	return nil
+}
+
+func (p *testServicePackage) SDKDataSources(context.Context) []*types.ServicePackageSDKDataSource {
+	return nil
+}
+
+func (p *testServicePackage) SDKResources(context.Context) []*types.ServicePackageSDKResource {
+	return nil
+}
+
+func (p *testServicePackage) ServicePackageName() string {
+	return p.name
+}
+
+type testTaggingServicePackage struct {
+	testServicePackage
+}
+
+func (p *testTaggingServicePackage) ListTags(context.Context, any, string) error {
+	return nil
+}
+
+func TestAWSClientTaggingServicePackage(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.TODO()
+	untagged := &testServicePackage{name: "untagged"}
+	tagged := &testTaggingServicePackage{testServicePackage{name: "tagged"}}
+	taggingAPI := &testServicePackage{name: names.ResourceGroupsTaggingAPI}
+	servicePackages := map[string]ServicePackage{
+		names.ResourceGroupsTaggingAPI: taggingAPI,
+	}
+	arn := "arn:aws:example:us-west-2:123456789012:thing/test" //lintignore:AWSAT003,AWSAT005
+
+	testCases := []struct {
+		name           string
+		fallback       bool
+		sp             ServicePackage
+		identifier     string
+		expected       ServicePackage
+		expectFallback bool
+	}{
+		{
+			name:       "not enabled",
+			sp:         untagged,
+			identifier: arn,
+			expected:   untagged,
+		},
+		{
+			name:       "service package tag methods",
+			fallback:   true,
+			sp:         tagged,
+			identifier: arn,
+			expected:   tagged,
+		},
+		{
+			name:       "not ARN",
+			fallback:   true,
+			sp:         untagged,
+			identifier: "thing-1234",
+			expected:   untagged,
+		},
+		{
+			name:           "fallback",
+			fallback:       true,
+			sp:             untagged,
+			identifier:     arn,
+			expected:       taggingAPI,
+			expectFallback: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &AWSClient{
+				resourceTaggingAPIFallback: testCase.fallback,
+				ServicePackages:            servicePackages,
+			}
+
+			got, fallback := client.TaggingServicePackage(ctx, testCase.sp, testCase.identifier)
+
+			if got != testCase.expected {
+				t.Errorf("got service package %s, expected %s", got.ServicePackageName(), testCase.expected.ServicePackageName())
+			}
+			if fallback != testCase.expectFallback {
+				t.Errorf("got fallback %t, expected %t", fallback, testCase.expectFallback)
+			}
+		})
+	}
+}
+
+func TestAWSClientSupportsTagging(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.TODO()
+	untagged := &testServicePackage{name: "untagged"}
+	tagged := &testTaggingServicePackage{testServicePackage{name: "tagged"}}
+
+	testCases := []struct {
+		name     string
+		fallback bool
+		sp       ServicePackage
+		expected bool
+	}{
+		{
+			name:     "service package tag methods",
+			sp:       tagged,
+			expected: true,
+		},
+		{
+			name: "no tag methods",
+			sp:   untagged,
+		},
+		{
+			name:     "no tag methods with fallback",
+			fallback: true,
+			sp:       untagged,
+			expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &AWSClient{
+				resourceTaggingAPIFallback: testCase.fallback,
+			}
+
+			if got, want := client.SupportsTagging(ctx, testCase.sp), testCase.expected; got != want {
+				t.Errorf("got %t, expected %t", got, want)
+			}
+		})
+	}
+}
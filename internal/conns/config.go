@@ -21,11 +21,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
 
+const (
+	defaultMaxBackoff = 300 * time.Second // AWS SDK for Go v1 DefaultRetryerMaxRetryDelay: https://github.com/aws/aws-sdk-go/blob/9f6e3bb9f523aef97fa1cd5c5f8ba8ecf212e44e/aws/client/default_retryer.go#L48-L49.
+)
+
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
@@ -42,11 +47,15 @@ type Config struct {
 	HTTPSProxy                     *string
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
+	LocalEmulator                  *LocalEmulatorConfig
 	MaxRetries                     int
+	MutationGuard                  MutationGuardFunc
 	NoProxy                        string
 	Profile                        string
 	Region                         string
+	ResourceTaggingAPIFallback     bool
 	RetryMode                      aws.RetryMode
+	RetryPolicies                  map[string]RetryPolicy
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
@@ -57,6 +66,7 @@ type Config struct {
 	SkipRequestingAccountId        bool
 	STSRegion                      string
 	SuppressDebugLog               bool
+	TagPolicyConfig                *tftags.PolicyConfig
 	TerraformVersion               string
 	Token                          string
 	TokenBucketRateLimiterCapacity int
@@ -70,9 +80,10 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	ctx, logger := logging.NewTfLogger(ctx)
 
-	const (
-		maxBackoff = 300 * time.Second // AWS SDK for Go v1 DefaultRetryerMaxRetryDelay: https://github.com/aws/aws-sdk-go/blob/9f6e3bb9f523aef97fa1cd5c5f8ba8ecf212e44e/aws/client/default_retryer.go#L48-L49.
-	)
+	if c.LocalEmulator != nil {
+		c.applyLocalEmulator(ctx, tfmaps.Keys(client.ServicePackages))
+	}
+
 	awsbaseConfig := awsbase.Config{
 		AccessKey:         c.AccessKey,
 		AllowedAccountIds: c.AllowedAccountIds,
@@ -85,7 +96,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		},
 		AssumeRole:                     c.AssumeRole,
 		AssumeRoleWithWebIdentity:      c.AssumeRoleWithWebIdentity,
-		Backoff:                        &v1CompatibleBackoff{maxRetryDelay: maxBackoff},
+		Backoff:                        &v1CompatibleBackoff{maxRetryDelay: defaultMaxBackoff},
 		CallerDocumentationURL:         "https://registry.terraform.io/providers/hashicorp/aws",
 		CallerName:                     "Terraform AWS Provider",
 		EC2MetadataServiceEnableState:  c.EC2MetadataServiceEnableState,
@@ -97,7 +108,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		HTTPSProxy:                     c.HTTPSProxy,
 		HTTPProxyMode:                  awsbase.HTTPProxyModeLegacy,
 		Logger:                         logger,
-		MaxBackoff:                     maxBackoff,
+		MaxBackoff:                     defaultMaxBackoff,
 		MaxRetries:                     c.MaxRetries,
 		NoProxy:                        c.NoProxy,
 		Profile:                        c.Profile,
@@ -156,6 +167,14 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	if apiMetricsEnabled() {
+		cfg.APIOptions = append(cfg.APIOptions, apiMetrics.addMiddleware)
+	}
+
+	if c.MutationGuard != nil {
+		cfg.APIOptions = append(cfg.APIOptions, mutationGuardMiddleware(c.MutationGuard))
+	}
+
 	if !c.SkipRegionValidation {
 		if err := basevalidation.SupportedRegion(cfg.Region); err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
@@ -180,6 +199,10 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	if c.MutationGuard != nil {
+		session.Handlers.Validate.PushFrontNamed(mutationGuardHandler(c.MutationGuard))
+	}
+
 	tflog.Debug(ctx, "Retrieving AWS account details")
 	accountID, partitionID, awsDiags := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	for _, d := range awsDiags {
@@ -190,6 +213,10 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		})
 	}
 
+	if c.LocalEmulator != nil {
+		accountID = c.LocalEmulator.AccountID
+	}
+
 	if accountID == "" {
 		diags = append(diags, errs.NewWarningDiagnostic(
 			"AWS account ID not found for provider",
@@ -210,6 +237,8 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.AccountID = accountID
 	client.defaultTagsConfig = c.DefaultTagsConfig
 	client.ignoreTagsConfig = c.IgnoreTagsConfig
+	client.tagPolicyConfig = c.TagPolicyConfig
+	client.resourceTaggingAPIFallback = c.ResourceTaggingAPIFallback
 	client.Region = c.Region
 	client.SetHTTPClient(ctx, session.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.session = session
@@ -220,6 +249,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.conns = make(map[string]any, 0)
 	client.endpoints = c.Endpoints
 	client.logger = logger
+	client.retryPolicies = c.RetryPolicies
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
@@ -507,3 +507,109 @@ func TestProxyConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestLocalEmulatorConfig(t *testing.T) { //nolint:paralleltest // uses t.Setenv
+	ctx := context.Background()
+
+	const endpoint = "http://localhost:4566"
+
+	cases := map[string]struct {
+		config               map[string]any
+		environmentVariables map[string]string
+		expectedAccountID    string
+		expectedEndpoints    map[string]string
+		expectedRegion       string
+	}{
+		"configuration block": {
+			config: map[string]any{
+				"local_emulator": []any{map[string]any{
+					"endpoint": endpoint,
+				}},
+			},
+			expectedAccountID: conns.DefaultLocalEmulatorAccountID,
+			expectedEndpoints: map[string]string{
+				"ec2": endpoint,
+				"s3":  endpoint,
+				"sts": endpoint,
+			},
+			expectedRegion: "us-east-1", // lintignore:AWSAT003
+		},
+		"environment variable": {
+			config: map[string]any{
+				"region": "us-west-2", // lintignore:AWSAT003
+			},
+			environmentVariables: map[string]string{
+				conns.LocalEmulatorEndpointEnvVar: endpoint,
+			},
+			expectedAccountID: conns.DefaultLocalEmulatorAccountID,
+			expectedEndpoints: map[string]string{
+				"ec2": endpoint,
+			},
+			expectedRegion: "us-west-2", // lintignore:AWSAT003
+		},
+		"account ID and service endpoint": {
+			config: map[string]any{
+				"endpoints": []any{map[string]any{
+					"s3": "http://localhost:9000",
+				}},
+				"local_emulator": []any{map[string]any{
+					"account_id": "123456789012",
+					"endpoint":   endpoint,
+				}},
+			},
+			expectedAccountID: "123456789012",
+			expectedEndpoints: map[string]string{
+				"ec2": endpoint,
+				"s3":  "http://localhost:9000",
+			},
+			expectedRegion: "us-east-1", // lintignore:AWSAT003
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			servicemocks.InitSessionTestEnv(t)
+
+			for k, v := range tc.environmentVariables {
+				t.Setenv(k, v)
+			}
+
+			p, err := provider.New(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			diags := p.Configure(ctx, terraformsdk.NewResourceConfigRaw(tc.config))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			meta := p.Meta().(*conns.AWSClient)
+
+			if got, want := meta.AccountID, tc.expectedAccountID; got != want {
+				t.Errorf("AccountID = %q, want %q", got, want)
+			}
+			if got, want := meta.Region, tc.expectedRegion; got != want {
+				t.Errorf("Region = %q, want %q", got, want)
+			}
+			if !meta.S3UsePathStyle(ctx) {
+				t.Error("S3UsePathStyle = false, want true")
+			}
+
+			endpoints := meta.Endpoints(ctx)
+			for k, want := range tc.expectedEndpoints {
+				if got := endpoints[k]; got != want {
+					t.Errorf("endpoint (%s) = %q, want %q", k, got, want)
+				}
+			}
+
+			credentials, err := meta.CredentialsProvider(ctx).Retrieve(ctx)
+			if err != nil {
+				t.Fatalf("retrieving credentials: %s", err)
+			}
+			if got, want := credentials.AccessKeyID, "test"; got != want {
+				t.Errorf("AccessKeyID = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -19,6 +19,20 @@ type ServicePackage interface {
 	ServicePackageName() string
 }
 
+// ServicePackageWithEphemeralResources is an interface that extends ServicePackage with ephemeral resources.
+// Ephemeral resources are resources that are not stored in the state file.
+type ServicePackageWithEphemeralResources interface {
+	ServicePackage
+	EphemeralResources(context.Context) []*types.ServicePackageEphemeralResource
+}
+
+// ServicePackageWithListResources is an interface that extends ServicePackage with list resources.
+// List resources enumerate the existing instances of a resource type.
+type ServicePackageWithListResources interface {
+	ServicePackage
+	ListResources(context.Context) []*types.ServicePackageListResource
+}
+
 type (
 	contextKeyType int
 )
@@ -29,30 +43,77 @@ var (
 
 // InContext represents the resource information kept in Context.
 type InContext struct {
+	AccountID          string // Provider's AWS account ID, set when importing
 	IsDataSource       bool   // Data source?
+	IsEphemeral        bool   // Ephemeral resource?
+	Operation          string // Operation being performed, e.g. "Create"
+	Region             string // Provider's AWS Region, set when importing
 	ResourceName       string // Friendly resource name, e.g. "Subnet"
 	ServicePackageName string // Canonical name defined as a constant in names package
+	TypeName           string // Terraform type name, e.g. "aws_subnet"
 }
 
-func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName, typeName string) context.Context {
 	v := InContext{
 		IsDataSource:       true,
 		ResourceName:       resourceName,
 		ServicePackageName: servicePackageName,
+		TypeName:           typeName,
+	}
+
+	return context.WithValue(ctx, contextKey, &v)
+}
+
+func NewEphemeralResourceContext(ctx context.Context, servicePackageName, resourceName, typeName string) context.Context {
+	v := InContext{
+		IsEphemeral:        true,
+		ResourceName:       resourceName,
+		ServicePackageName: servicePackageName,
+		TypeName:           typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
 }
 
-func NewResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewResourceContext(ctx context.Context, servicePackageName, resourceName, typeName string) context.Context {
 	v := InContext{
 		ResourceName:       resourceName,
 		ServicePackageName: servicePackageName,
+		TypeName:           typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
 }
 
+// NewOperationContext returns a copy of the resource information in Context, recording the operation being performed.
+func NewOperationContext(ctx context.Context, operation string) context.Context {
+	v, ok := FromContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	w := *v
+	w.Operation = operation
+
+	return context.WithValue(ctx, contextKey, &w)
+}
+
+// NewImportContext returns a copy of the resource information in Context, recording an import
+// into the specified AWS account and Region.
+func NewImportContext(ctx context.Context, accountID, region string) context.Context {
+	v, ok := FromContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	w := *v
+	w.AccountID = accountID
+	w.Operation = "Import"
+	w.Region = region
+
+	return context.WithValue(ctx, contextKey, &w)
+}
+
 func FromContext(ctx context.Context) (*InContext, bool) {
 	v, ok := ctx.Value(contextKey).(*InContext)
 	return v, ok
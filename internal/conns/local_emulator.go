@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"os"
+
+	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// LocalEmulatorEndpointEnvVar is the environment variable which configures the provider for use with a local AWS emulator.
+	LocalEmulatorEndpointEnvVar = "TF_AWS_LOCAL_EMULATOR_ENDPOINT"
+
+	// DefaultLocalEmulatorAccountID is the AWS account ID used with a local AWS emulator if none is configured.
+	DefaultLocalEmulatorAccountID = "000000000000"
+
+	localEmulatorAccessKey = "test"
+	localEmulatorSecretKey = "test"
+	localEmulatorRegion    = "us-east-1" // lintignore:AWSAT003
+)
+
+// LocalEmulatorConfig configures the provider for use with a local AWS stand-in such as LocalStack or moto.
+type LocalEmulatorConfig struct {
+	AccountID string
+	Endpoint  string
+}
+
+// applyLocalEmulator adjusts the configuration for use with a local AWS emulator:
+//   - Every service without an explicitly configured endpoint uses the emulator's endpoint
+//   - S3 uses path-style addressing
+//   - Credentials and region are not validated and the caller identity is not requested;
+//     the emulator's account ID is used instead
+//   - The EC2 metadata service is not used
+//   - Placeholder credentials and region are used if none are otherwise available
+func (c *Config) applyLocalEmulator(ctx context.Context, servicePackageNames []string) {
+	if c.LocalEmulator.AccountID == "" {
+		c.LocalEmulator.AccountID = DefaultLocalEmulatorAccountID
+	}
+
+	if c.Endpoints == nil {
+		c.Endpoints = make(map[string]string)
+	}
+	// IAM, SSO and STS endpoints are used during provider configuration.
+	for _, v := range append(servicePackageNames, names.IAM, names.SSO, names.STS) {
+		if c.Endpoints[v] == "" {
+			c.Endpoints[v] = c.LocalEmulator.Endpoint
+		}
+	}
+
+	c.EC2MetadataServiceEnableState = imds.ClientDisabled
+	c.S3UsePathStyle = true
+	c.SkipCredsValidation = true
+	c.SkipRegionValidation = true
+	c.SkipRequestingAccountId = true
+
+	if c.AccessKey == "" && c.Profile == "" && len(c.SharedCredentialsFiles) == 0 && os.Getenv("AWS_ACCESS_KEY_ID") == "" && os.Getenv("AWS_PROFILE") == "" {
+		c.AccessKey = localEmulatorAccessKey
+		c.SecretKey = localEmulatorSecretKey
+	}
+
+	if c.Region == "" && os.Getenv("AWS_REGION") == "" && os.Getenv("AWS_DEFAULT_REGION") == "" {
+		c.Region = localEmulatorRegion
+	}
+
+	tflog.Info(ctx, "Configuring provider for local AWS emulator", map[string]any{
+		"tf_aws.local_emulator.endpoint":   c.LocalEmulator.Endpoint,
+		"tf_aws.local_emulator.account_id": c.LocalEmulator.AccountID,
+	})
+}
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// MutationGuardFunc is called before each AWS API call with the call's service ID and operation name.
+// A non-nil error refuses the call.
+type MutationGuardFunc func(ctx context.Context, serviceID, operation string) error
+
+// mutationGuardMiddleware returns middleware calling the specified guard for an AWS SDK for Go v2 middleware stack.
+func mutationGuardMiddleware(guard MutationGuardFunc) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("TerraformMutationGuard", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			if err := guard(ctx, awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx)); err != nil {
+				return middleware.InitializeOutput{}, middleware.Metadata{}, err
+			}
+
+			return next.HandleInitialize(ctx, in)
+		}), middleware.Before)
+	}
+}
+
+// mutationGuardHandler returns a handler calling the specified guard for AWS SDK for Go v1 requests.
+func mutationGuardHandler(guard MutationGuardFunc) request.NamedHandler {
+	return request.NamedHandler{
+		Name: "TerraformMutationGuard",
+		Fn: func(r *request.Request) {
+			if err := guard(r.Context(), r.ClientInfo.ServiceID, r.Operation.Name); err != nil {
+				r.Error = err
+			}
+		},
+	}
+}
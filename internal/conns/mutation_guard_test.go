@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestMutationGuardMiddleware(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	errRefused := errors.New("refused")
+
+	testCases := []struct {
+		name      string
+		operation string
+		wantErr   bool
+	}{
+		{
+			name:      "allowed",
+			operation: "DescribeRouteTables",
+		},
+		{
+			name:      "refused",
+			operation: "DeleteRouteTable",
+			wantErr:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotServiceID, gotOperation string
+			guard := func(_ context.Context, serviceID, operation string) error {
+				gotServiceID, gotOperation = serviceID, operation
+				if operation == "DeleteRouteTable" {
+					return errRefused
+				}
+				return nil
+			}
+
+			stack := middleware.NewStack("test", smithyhttp.NewStackRequest)
+			if err := mutationGuardMiddleware(guard)(stack); err != nil {
+				t.Fatal(err)
+			}
+			// Service metadata is registered before the guard runs.
+			if err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{
+				ServiceID:     "EC2",
+				OperationName: testCase.operation,
+			}, middleware.Before); err != nil {
+				t.Fatal(err)
+			}
+
+			var called bool
+			handler := middleware.DecorateHandler(middleware.HandlerFunc(func(context.Context, any) (any, middleware.Metadata, error) {
+				called = true
+				return nil, middleware.Metadata{}, nil
+			}), stack)
+			_, _, err := handler.Handle(ctx, nil)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("err %t, want %t: %v", got, want, err)
+			}
+			if err != nil && !errors.Is(err, errRefused) {
+				t.Errorf("err %v, want %v", err, errRefused)
+			}
+			if got, want := called, !testCase.wantErr; got != want {
+				t.Errorf("API call made %t, want %t", got, want)
+			}
+			if gotServiceID != "EC2" || gotOperation != testCase.operation {
+				t.Errorf("guard called with (%q, %q), want (%q, %q)", gotServiceID, gotOperation, "EC2", testCase.operation)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+)
+
+// RetryPolicy overrides the provider-wide retry settings for a single service's AWS SDK for Go v2 API client.
+// Any retry customization in the service package's client factory is applied on top of the policy.
+type RetryPolicy struct {
+	MaxAttempts         int
+	MaxBackoff          time.Duration
+	RetryMode           aws.RetryMode
+	RetryableErrorCodes []string
+}
+
+// retryer returns a Retryer factory which applies the policy to the Retryer returned by base.
+func (p RetryPolicy) retryer(base func() aws.Retryer) func() aws.Retryer {
+	return func() aws.Retryer {
+		var r aws.Retryer
+		if base != nil {
+			r = base()
+		} else {
+			r = retry.NewStandard()
+		}
+
+		// A different retry mode replaces the provider's Retryer, keeping its maximum attempts and backoff.
+		switch maxAttempts := r.MaxAttempts(); p.RetryMode {
+		case aws.RetryModeAdaptive:
+			r = retry.NewAdaptiveMode(func(o *retry.AdaptiveModeOptions) {
+				o.StandardOptions = append(o.StandardOptions, func(o *retry.StandardOptions) {
+					o.MaxAttempts = maxAttempts
+					o.Backoff = &v1CompatibleBackoff{maxRetryDelay: defaultMaxBackoff}
+				})
+			})
+		case aws.RetryModeStandard:
+			r = retry.NewStandard(func(o *retry.StandardOptions) {
+				o.MaxAttempts = maxAttempts
+				o.Backoff = &v1CompatibleBackoff{maxRetryDelay: defaultMaxBackoff}
+			})
+		}
+
+		if p.MaxAttempts > 0 {
+			r = retry.AddWithMaxAttempts(r, p.MaxAttempts)
+		}
+
+		if p.MaxBackoff > 0 {
+			r = &withBackoff{
+				RetryerV2: r.(aws.RetryerV2),
+				backoff:   &v1CompatibleBackoff{maxRetryDelay: p.MaxBackoff},
+			}
+		}
+
+		if len(p.RetryableErrorCodes) > 0 {
+			codes := make(map[string]struct{}, len(p.RetryableErrorCodes))
+			for _, code := range p.RetryableErrorCodes {
+				codes[code] = struct{}{}
+			}
+			r = AddIsErrorRetryables(r.(aws.RetryerV2), retry.RetryableErrorCode{Codes: codes})
+		}
+
+		return r
+	}
+}
+
+type withBackoff struct {
+	aws.RetryerV2
+	backoff retry.BackoffDelayer
+}
+
+func (r *withBackoff) RetryDelay(attempt int, err error) (time.Duration, error) {
+	return r.backoff.BackoffDelay(attempt, err)
+}
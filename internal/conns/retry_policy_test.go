@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	smithy "github.com/aws/smithy-go"
+)
+
+func TestRetryPolicyRetryer(t *testing.T) {
+	t.Parallel()
+
+	base := func() aws.Retryer {
+		return retry.NewStandard(func(o *retry.StandardOptions) {
+			o.MaxAttempts = 25
+		})
+	}
+	throttled := &smithy.GenericAPIError{Code: "TooManyRequestsException"}
+	custom := &smithy.GenericAPIError{Code: "ConcurrentModificationException"}
+
+	testCases := []struct {
+		name                string
+		policy              RetryPolicy
+		expectedMaxAttempts int
+		expectedRetryable   map[error]bool
+	}{
+		{
+			name:                "empty",
+			expectedMaxAttempts: 25,
+			expectedRetryable: map[error]bool{
+				throttled:                      true,
+				custom:                         false,
+				errors.New("not an API error"): false,
+			},
+		},
+		{
+			name: "max attempts",
+			policy: RetryPolicy{
+				MaxAttempts: 50,
+			},
+			expectedMaxAttempts: 50,
+		},
+		{
+			name: "adaptive",
+			policy: RetryPolicy{
+				RetryMode: aws.RetryModeAdaptive,
+			},
+			expectedMaxAttempts: 25,
+			expectedRetryable: map[error]bool{
+				throttled: true,
+			},
+		},
+		{
+			name: "retryable error codes",
+			policy: RetryPolicy{
+				MaxAttempts:         10,
+				MaxBackoff:          5 * time.Second,
+				RetryableErrorCodes: []string{"ConcurrentModificationException"},
+			},
+			expectedMaxAttempts: 10,
+			expectedRetryable: map[error]bool{
+				throttled: true,
+				custom:    true,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := testCase.policy.retryer(base)()
+
+			if _, ok := r.(aws.RetryerV2); !ok {
+				t.Errorf("%T is not an aws.RetryerV2", r)
+			}
+			if got, want := r.MaxAttempts(), testCase.expectedMaxAttempts; got != want {
+				t.Errorf("MaxAttempts = %d, want %d", got, want)
+			}
+			for err, want := range testCase.expectedRetryable {
+				if got := r.IsErrorRetryable(err); got != want {
+					t.Errorf("IsErrorRetryable(%v) = %t, want %t", err, got, want)
+				}
+			}
+			if testCase.policy.MaxBackoff > 0 {
+				for attempt := 1; attempt < 10; attempt++ {
+					delay, err := r.RetryDelay(attempt, throttled)
+					if err != nil {
+						t.Fatalf("RetryDelay: %s", err)
+					}
+					if delay > testCase.policy.MaxBackoff {
+						t.Errorf("RetryDelay(%d) = %s, want at most %s", attempt, delay, testCase.policy.MaxBackoff)
+					}
+				}
+			}
+		})
+	}
+}
@@ -116,3 +116,27 @@ func (g *nameGenerator) Generate() string {
 	}
 	return id.PrefixedUniqueId(prefix) + g.suffix
 }
+
+// TruncateName returns name truncated to at most maxLength characters.
+//
+// If name ends with a Terraform generated unique ID suffix, for example a
+// value returned by Name with a configured prefix, the prefix is truncated
+// so that the generated name remains unique.
+// Truncation never splits a multi-byte character.
+func TruncateName(name string, maxLength int) string {
+	if maxLength < 0 {
+		maxLength = 0
+	}
+
+	runes := []rune(name)
+	if len(runes) <= maxLength {
+		return name
+	}
+
+	if namePrefix := NamePrefixFromName(name); namePrefix != nil && maxLength >= id.UniqueIDSuffixLength {
+		prefix := []rune(*namePrefix)
+		return string(prefix[:maxLength-id.UniqueIDSuffixLength]) + name[len(*namePrefix):]
+	}
+
+	return string(runes[:maxLength])
+}
@@ -416,3 +416,75 @@ func TestNamePrefixFromNameWithSuffix(t *testing.T) {
 		}
 	})
 }
+
+func TestTruncateName(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName  string
+		name      string
+		maxLength int
+		expected  string
+	}{
+		{
+			testName:  "empty",
+			name:      "",
+			maxLength: 10,
+			expected:  "",
+		},
+		{
+			testName:  "shorter than max",
+			name:      "testing",
+			maxLength: 10,
+			expected:  "testing",
+		},
+		{
+			testName:  "equal to max",
+			name:      "testing",
+			maxLength: 7,
+			expected:  "testing",
+		},
+		{
+			testName:  "longer than max",
+			name:      "testing",
+			maxLength: 4,
+			expected:  "test",
+		},
+		{
+			testName:  "multi-byte characters",
+			name:      "tést-ñame",
+			maxLength: 6,
+			expected:  "tést-ñ",
+		},
+		{
+			testName:  "zero max",
+			name:      "testing",
+			maxLength: 0,
+			expected:  "",
+		},
+		{
+			testName:  "generated name",
+			name:      "a-long-prefix-20060102150405000000000001",
+			maxLength: 30,
+			expected:  "a-lo20060102150405000000000001",
+		},
+		{
+			testName:  "generated name max less than suffix length",
+			name:      "a-long-prefix-20060102150405000000000001",
+			maxLength: 10,
+			expected:  "a-long-pre",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			got := TruncateName(testCase.name, testCase.maxLength)
+
+			if got != testCase.expected {
+				t.Errorf("TruncateName(%q, %d) = %q, expected %q", testCase.name, testCase.maxLength, got, testCase.expected)
+			}
+		})
+	}
+}
@@ -84,6 +84,36 @@ const (
 	AssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used with resource sweepers
+const (
+	// The maximum number of sweepers run concurrently in a region.
+	// Defaults to 10.
+	SweepParallelism = "TF_AWS_SWEEP_PARALLELISM"
+
+	// If true, sweepers list the resources they would delete without deleting them
+	SweepDryRun = "TF_AWS_SWEEP_DRY_RUN"
+
+	// Path of a report of the resources swept, or that would be swept in dry-run mode.
+	// The report is written as CSV if the path ends in ".csv", otherwise as JSON.
+	SweepReport = "TF_AWS_SWEEP_REPORT"
+
+	// Comma-separated list of tags ("key" or "key=value").
+	// If set, only resources with at least one of the tags are swept
+	SweepAllowTags = "TF_AWS_SWEEP_ALLOW_TAGS"
+
+	// Comma-separated list of tags ("key" or "key=value").
+	// Resources with any of the tags are not swept
+	SweepDenyTags = "TF_AWS_SWEEP_DENY_TAGS"
+
+	// Comma-separated list of name prefixes.
+	// If set, only resources whose name begins with one of the prefixes are swept
+	SweepAllowNamePrefixes = "TF_AWS_SWEEP_ALLOW_NAME_PREFIXES"
+
+	// Comma-separated list of name prefixes.
+	// Resources whose name begins with any of the prefixes are not swept
+	SweepDenyNamePrefixes = "TF_AWS_SWEEP_DENY_NAME_PREFIXES"
+)
+
 // GetWithDefault gets an environment variable value if non-empty or returns the default.
 func GetWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
@@ -124,6 +124,11 @@ func (expander autoExpander) convert(ctx context.Context, sourcePath path.Path,
 
 	tflog.SubsystemInfo(ctx, subsystemName, "Converting")
 
+	if f, ok := expander.Options.converter(valFrom.Type(), vTo.Type()); ok {
+		diags.Append(convertCustom(ctx, f, valFrom, vTo)...)
+		return diags
+	}
+
 	if fromExpander, ok := valFrom.Interface().(Expander); ok {
 		tflog.SubsystemInfo(ctx, subsystemName, "Source implements flex.Expander")
 		diags.Append(expandExpander(ctx, fromExpander, vTo)...)
@@ -487,6 +492,32 @@ func (expander autoExpander) string(ctx context.Context, vFrom basetypes.StringV
 			return diags
 		}
 
+		//
+		// types.String -> Smithy document.
+		//
+		if f := expander.Options.smithyDocumentFactory; f != nil && tTo.Implements(reflect.TypeFor[smithyjson.JSONStringer]()) {
+			if v.IsNull() || v.IsUnknown() {
+				return diags
+			}
+
+			doc, err := smithyjson.SmithyDocumentFromString(v.ValueString(), f)
+			if err != nil {
+				tflog.SubsystemError(ctx, subsystemName, "Unmarshalling JSON document", map[string]any{
+					logAttrKeyError: err.Error(),
+				})
+				diags.Append(diagExpandingUnmarshalSmithyDocument(tTo, err))
+				return diags
+			}
+
+			if t := reflect.TypeOf(doc); !t.Implements(tTo) {
+				diags.Append(diagExpandedTypeDoesNotImplement(t, tTo))
+				return diags
+			}
+
+			vTo.Set(reflect.ValueOf(doc))
+			return diags
+		}
+
 	case reflect.Pointer:
 		switch tElem := tTo.Elem(); tElem.Kind() {
 		case reflect.String:
@@ -1311,6 +1342,16 @@ func diagExpandingNoMapBlockKey(sourceType reflect.Type) diag.ErrorDiagnostic {
 	)
 }
 
+func diagExpandingUnmarshalSmithyDocument(targetType reflect.Type, err error) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while expanding configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Unmarshalling JSON document of type %q failed: %s", fullTypeName(targetType), err.Error()),
+	)
+}
+
 func diagExpandingIncompatibleTypes(sourceType, targetType reflect.Type) diag.ErrorDiagnostic {
 	return diag.NewErrorDiagnostic(
 		"Incompatible Types",
@@ -4584,6 +4584,165 @@ func TestExpandIgnoreStructTag(t *testing.T) {
 	runAutoExpandTestCases(t, testCases)
 }
 
+func TestExpandFieldNameStructTag(t *testing.T) {
+	t.Parallel()
+
+	testCases := autoFlexTestCases{
+		"mapped fields": {
+			Source: &tfFieldNameTag{
+				Name: types.StringValue("value1"),
+				Arn:  types.StringValue(""),
+			},
+			Target: &awsFieldNameTag{},
+			WantTarget: &awsFieldNameTag{
+				FieldName: aws.String("value1"),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfFieldNameTag](), reflect.TypeFor[*awsFieldNameTag]()),
+				infoConverting(reflect.TypeFor[tfFieldNameTag](), reflect.TypeFor[*awsFieldNameTag]()),
+				traceMatchedFields("Name", reflect.TypeFor[tfFieldNameTag](), "FieldName", reflect.TypeFor[*awsFieldNameTag]()),
+				infoConvertingWithPath("Name", reflect.TypeFor[types.String](), "FieldName", reflect.TypeFor[*string]()),
+				traceMatchedFields("Arn", reflect.TypeFor[tfFieldNameTag](), "ResourceArn", reflect.TypeFor[*awsFieldNameTag]()),
+				infoConvertingWithPath("Arn", reflect.TypeFor[types.String](), "ResourceArn", reflect.TypeFor[*string]()),
+				debugUsingLegacyExpander("Arn", reflect.TypeFor[types.String](), "ResourceArn", reflect.TypeFor[*string]()),
+			},
+		},
+		"mapped field not found": {
+			Source: &tfFieldNameTag{
+				Name: types.StringValue("value1"),
+				Arn:  types.StringValue("value2"),
+			},
+			Target:     &awsSingleStringPointer{},
+			WantTarget: &awsSingleStringPointer{},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfFieldNameTag](), reflect.TypeFor[*awsSingleStringPointer]()),
+				infoConverting(reflect.TypeFor[tfFieldNameTag](), reflect.TypeFor[*awsSingleStringPointer]()),
+				debugNoCorrespondingField(reflect.TypeFor[tfFieldNameTag](), "Name", reflect.TypeFor[*awsSingleStringPointer]()),
+				debugNoCorrespondingField(reflect.TypeFor[tfFieldNameTag](), "Arn", reflect.TypeFor[*awsSingleStringPointer]()),
+			},
+		},
+	}
+
+	runAutoExpandTestCases(t, testCases)
+}
+
+func TestExpandConverter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testTimeTime := errs.Must(time.Parse(time.RFC3339, "2013-09-25T09:34:01Z"))
+
+	testCases := autoFlexTestCases{
+		"custom converters": {
+			Options: []AutoFlexOptionsFunc{
+				WithConverter(RFC3339ToEpochSeconds),
+				WithConverter(ListToCommaSeparatedString),
+			},
+			Source: &tfConverter{
+				CreationTime: timetypes.NewRFC3339TimeValue(testTimeTime),
+				Values:       FlattenFrameworkStringValueListOfString(ctx, []string{"a", "b", "c"}),
+			},
+			Target: &awsConverter{},
+			WantTarget: &awsConverter{
+				CreationTime: aws.Int64(testTimeTime.Unix()),
+				Values:       aws.String("a,b,c"),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfConverter](), reflect.TypeFor[*awsConverter]()),
+				infoConverting(reflect.TypeFor[tfConverter](), reflect.TypeFor[*awsConverter]()),
+				traceMatchedFields("CreationTime", reflect.TypeFor[tfConverter](), "CreationTime", reflect.TypeFor[*awsConverter]()),
+				infoConvertingWithPath("CreationTime", reflect.TypeFor[timetypes.RFC3339](), "CreationTime", reflect.TypeFor[*int64]()),
+				infoUsingCustomConverter("CreationTime", reflect.TypeFor[timetypes.RFC3339](), "CreationTime", reflect.TypeFor[*int64]()),
+				traceMatchedFields("Values", reflect.TypeFor[tfConverter](), "Values", reflect.TypeFor[*awsConverter]()),
+				infoConvertingWithPath("Values", reflect.TypeFor[fwtypes.ListValueOf[types.String]](), "Values", reflect.TypeFor[*string]()),
+				infoUsingCustomConverter("Values", reflect.TypeFor[fwtypes.ListValueOf[types.String]](), "Values", reflect.TypeFor[*string]()),
+				// ExpandFrameworkStringValueList in ListToCommaSeparatedString()
+				infoExpandingWithPath("Values", reflect.TypeFor[fwtypes.ListValueOf[types.String]](), "Values", reflect.TypeFor[*[]string]()), // TODO: fix path
+				infoConverting(reflect.TypeFor[fwtypes.ListValueOf[types.String]](), reflect.TypeFor[[]string]()),
+				traceExpandingWithElementsAs("", reflect.TypeFor[fwtypes.ListValueOf[types.String]](), 3, "", reflect.TypeFor[[]string]()),
+			},
+		},
+		"null values": {
+			Options: []AutoFlexOptionsFunc{
+				WithConverter(RFC3339ToEpochSeconds),
+				WithConverter(ListToCommaSeparatedString),
+			},
+			Source: &tfConverter{
+				CreationTime: timetypes.NewRFC3339Null(),
+				Values:       fwtypes.NewListValueOfNull[types.String](ctx),
+			},
+			Target:     &awsConverter{},
+			WantTarget: &awsConverter{},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfConverter](), reflect.TypeFor[*awsConverter]()),
+				infoConverting(reflect.TypeFor[tfConverter](), reflect.TypeFor[*awsConverter]()),
+				traceMatchedFields("CreationTime", reflect.TypeFor[tfConverter](), "CreationTime", reflect.TypeFor[*awsConverter]()),
+				infoConvertingWithPath("CreationTime", reflect.TypeFor[timetypes.RFC3339](), "CreationTime", reflect.TypeFor[*int64]()),
+				infoUsingCustomConverter("CreationTime", reflect.TypeFor[timetypes.RFC3339](), "CreationTime", reflect.TypeFor[*int64]()),
+				traceMatchedFields("Values", reflect.TypeFor[tfConverter](), "Values", reflect.TypeFor[*awsConverter]()),
+				infoConvertingWithPath("Values", reflect.TypeFor[fwtypes.ListValueOf[types.String]](), "Values", reflect.TypeFor[*string]()),
+				infoUsingCustomConverter("Values", reflect.TypeFor[fwtypes.ListValueOf[types.String]](), "Values", reflect.TypeFor[*string]()),
+			},
+		},
+	}
+
+	runAutoExpandTestCases(t, testCases)
+}
+
+func TestExpandStrictFieldMatching(t *testing.T) {
+	t.Parallel()
+
+	testCases := autoFlexTestCases{
+		"matched": {
+			Options: []AutoFlexOptionsFunc{WithStrictFieldMatching()},
+			Source: &tfSingleStringField{
+				Field1: types.StringValue("value1"),
+			},
+			Target: &awsSingleStringValue{},
+			WantTarget: &awsSingleStringValue{
+				Field1: "value1",
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfSingleStringField](), reflect.TypeFor[*awsSingleStringValue]()),
+				infoConverting(reflect.TypeFor[tfSingleStringField](), reflect.TypeFor[*awsSingleStringValue]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfSingleStringField](), "Field1", reflect.TypeFor[*awsSingleStringValue]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[string]()),
+			},
+		},
+		"unmatched": {
+			Options: []AutoFlexOptionsFunc{WithStrictFieldMatching()},
+			Source: &tfSingleStringField{
+				Field1: types.StringValue("value1"),
+			},
+			Target: &emptyStruct{},
+			expectedDiags: diag.Diagnostics{
+				diagConvertingNoCorrespondingField(reflect.TypeFor[tfSingleStringField](), "Field1", reflect.TypeFor[emptyStruct]()),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfSingleStringField](), reflect.TypeFor[*emptyStruct]()),
+				infoConverting(reflect.TypeFor[tfSingleStringField](), reflect.TypeFor[*emptyStruct]()),
+				errorNoCorrespondingField(reflect.TypeFor[tfSingleStringField](), "Field1", reflect.TypeFor[*emptyStruct]()),
+			},
+		},
+		"ignored": {
+			Options: []AutoFlexOptionsFunc{WithStrictFieldMatching()},
+			Source: &tfSingleStringFieldIgnore{
+				Field1: types.StringValue("value1"),
+			},
+			Target:     &emptyStruct{},
+			WantTarget: &emptyStruct{},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfSingleStringFieldIgnore](), reflect.TypeFor[*emptyStruct]()),
+				infoConverting(reflect.TypeFor[tfSingleStringFieldIgnore](), reflect.TypeFor[*emptyStruct]()),
+				traceSkipIgnoredSourceField(reflect.TypeFor[tfSingleStringFieldIgnore](), "Field1", reflect.TypeFor[*emptyStruct]()),
+			},
+		},
+	}
+
+	runAutoExpandTestCases(t, testCases)
+}
+
 func TestExpandInterface(t *testing.T) {
 	t.Parallel()
 
@@ -4845,6 +5004,230 @@ func testFlexAWSInterfaceInterfacePtr(v awsInterfaceInterface) *awsInterfaceInte
 	return &v
 }
 
+func TestExpandUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"nested block member": {
+			Options: []AutoFlexOptionsFunc{WithUnionMembers(awsUnionMembers...)},
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Nested: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{
+								Field1: types.StringValue("value1"),
+							},
+						}),
+						Text:     types.StringNull(),
+						Location: types.StringNull(),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{
+				Field1: &awsUnionMemberNested{
+					Value: awsSingleStringValue{
+						Field1: "value1",
+					},
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnion]()),
+				infoTargetIsUnion("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnion]()),
+				traceMatchedUnionMember("Field1[0]", "Nested", reflect.TypeFor[tfUnion](), "Field1", "awsUnionMemberNested", reflect.TypeFor[*awsUnion]()),
+				infoConvertingWithPath("Field1[0].Nested", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfSingleStringField]](), "Field1.Value", reflect.TypeFor[awsSingleStringValue]()),
+				traceMatchedFieldsWithPath("Field1[0].Nested[0]", "Field1", reflect.TypeFor[tfSingleStringField](), "Field1.Value", "Field1", reflect.TypeFor[*awsSingleStringValue]()),
+				infoConvertingWithPath("Field1[0].Nested[0].Field1", reflect.TypeFor[types.String](), "Field1.Value.Field1", reflect.TypeFor[string]()),
+			},
+		},
+		"attribute member": {
+			Options: []AutoFlexOptionsFunc{WithUnionMembers(awsUnionMembers...)},
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Nested:   fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+						Text:     types.StringValue("value1"),
+						Location: types.StringNull(),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{
+				Field1: &awsUnionMemberText{
+					Value: "value1",
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnion]()),
+				infoTargetIsUnion("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnion]()),
+				traceMatchedUnionMember("Field1[0]", "Text", reflect.TypeFor[tfUnion](), "Field1", "awsUnionMemberText", reflect.TypeFor[*awsUnion]()),
+				infoConvertingWithPath("Field1[0].Text", reflect.TypeFor[types.String](), "Field1.Value", reflect.TypeFor[string]()),
+			},
+		},
+		"struct tag member": {
+			Options: []AutoFlexOptionsFunc{WithUnionMembers(awsUnionMembers...)},
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Nested:   fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+						Text:     types.StringNull(),
+						Location: types.StringValue("value1"),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{
+				Field1: &awsUnionMemberUri{
+					Value: "value1",
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnion]()),
+				infoTargetIsUnion("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnion]()),
+				traceMatchedUnionMember("Field1[0]", "Location", reflect.TypeFor[tfUnion](), "Field1", "awsUnionMemberUri", reflect.TypeFor[*awsUnion]()),
+				infoConvertingWithPath("Field1[0].Location", reflect.TypeFor[types.String](), "Field1.Value", reflect.TypeFor[string]()),
+			},
+		},
+		"no member": {
+			Options: []AutoFlexOptionsFunc{WithUnionMembers(awsUnionMembers...)},
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Nested:   fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{}),
+						Text:     types.StringNull(),
+						Location: types.StringUnknown(),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{
+				Field1: nil,
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnion]()),
+				infoTargetIsUnion("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnion]()),
+				debugNoUnionMember("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnion]()),
+			},
+		},
+		"multiple members": {
+			Options: []AutoFlexOptionsFunc{WithUnionMembers(awsUnionMembers...)},
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Nested:   fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+						Text:     types.StringValue("value1"),
+						Location: types.StringValue("value2"),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			expectedDiags: diag.Diagnostics{
+				diagExpandingMultipleUnionMembers(reflect.TypeFor[tfUnion](), reflect.TypeFor[awsUnion]()),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnion]()),
+				infoTargetIsUnion("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnion]()),
+				traceMatchedUnionMember("Field1[0]", "Text", reflect.TypeFor[tfUnion](), "Field1", "awsUnionMemberText", reflect.TypeFor[*awsUnion]()),
+				infoConvertingWithPath("Field1[0].Text", reflect.TypeFor[types.String](), "Field1.Value", reflect.TypeFor[string]()),
+				errorMultipleUnionMembers("Field1[0]", reflect.TypeFor[tfUnion](), "Location", "Field1", reflect.TypeFor[*awsUnion]()),
+			},
+		},
+		"slice of unions": {
+			Options: []AutoFlexOptionsFunc{WithUnionMembers(awsUnionMembers...)},
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Nested:   fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+						Text:     types.StringValue("value1"),
+						Location: types.StringNull(),
+					},
+					{
+						Nested:   fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+						Text:     types.StringNull(),
+						Location: types.StringValue("value2"),
+					},
+				}),
+			},
+			Target: &awsUnionSlice{},
+			WantTarget: &awsUnionSlice{
+				Field1: []awsUnion{
+					&awsUnionMemberText{
+						Value: "value1",
+					},
+					&awsUnionMemberUri{
+						Value: "value2",
+					},
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSlice]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSlice]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSlice]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[[]awsUnion]()),
+				traceExpandingNestedObjectCollection("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), 2, "Field1", reflect.TypeFor[[]awsUnion]()),
+				infoTargetIsUnion("Field1[0]", reflect.TypeFor[tfUnion](), "Field1[0]", reflect.TypeFor[*awsUnion]()),
+				traceMatchedUnionMember("Field1[0]", "Text", reflect.TypeFor[tfUnion](), "Field1[0]", "awsUnionMemberText", reflect.TypeFor[*awsUnion]()),
+				infoConvertingWithPath("Field1[0].Text", reflect.TypeFor[types.String](), "Field1[0].Value", reflect.TypeFor[string]()),
+				infoTargetIsUnion("Field1[1]", reflect.TypeFor[tfUnion](), "Field1[1]", reflect.TypeFor[*awsUnion]()),
+				traceMatchedUnionMember("Field1[1]", "Location", reflect.TypeFor[tfUnion](), "Field1[1]", "awsUnionMemberUri", reflect.TypeFor[*awsUnion]()),
+				infoConvertingWithPath("Field1[1].Location", reflect.TypeFor[types.String](), "Field1[1].Value", reflect.TypeFor[string]()),
+			},
+		},
+		"String Source to json interface Target": {
+			Options: []AutoFlexOptionsFunc{WithSmithyDocumentFactory(newTestJSONDocument)},
+			Source:  &tfSingleStringField{Field1: types.StringValue(`{"field1": "a"}`)},
+			Target:  &awsJSONStringer{},
+			WantTarget: &awsJSONStringer{
+				Field1: &testJSONDocument{
+					Value: map[string]any{
+						"field1": "a",
+					},
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfSingleStringField](), reflect.TypeFor[*awsJSONStringer]()),
+				infoConverting(reflect.TypeFor[tfSingleStringField](), reflect.TypeFor[*awsJSONStringer]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfSingleStringField](), "Field1", reflect.TypeFor[*awsJSONStringer]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[smithyjson.JSONStringer]()),
+			},
+		},
+		"null String Source to json interface Target": {
+			Options: []AutoFlexOptionsFunc{WithSmithyDocumentFactory(newTestJSONDocument)},
+			Source:  &tfSingleStringField{Field1: types.StringNull()},
+			Target:  &awsJSONStringer{},
+			WantTarget: &awsJSONStringer{
+				Field1: nil,
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfSingleStringField](), reflect.TypeFor[*awsJSONStringer]()),
+				infoConverting(reflect.TypeFor[tfSingleStringField](), reflect.TypeFor[*awsJSONStringer]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfSingleStringField](), "Field1", reflect.TypeFor[*awsJSONStringer]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[smithyjson.JSONStringer]()),
+				traceExpandingNullValue("Field1", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[smithyjson.JSONStringer]()),
+			},
+		},
+	}
+
+	runAutoExpandTestCases(t, testCases)
+}
+
 func TestExpandExpander(t *testing.T) {
 	t.Parallel()
 
@@ -145,6 +145,13 @@ func (flattener autoFlattener) convert(ctx context.Context, sourcePath path.Path
 
 	tflog.SubsystemInfo(ctx, subsystemName, "Converting")
 
+	if vFrom.IsValid() {
+		if f, ok := flattener.Options.converter(vFrom.Type(), vTo.Type()); ok {
+			diags.Append(convertCustom(ctx, f, vFrom, vTo)...)
+			return diags
+		}
+	}
+
 	tTo := valTo.Type(ctx)
 	switch k := vFrom.Kind(); k {
 	case reflect.Bool:
@@ -188,7 +195,7 @@ func (flattener autoFlattener) convert(ctx context.Context, sourcePath path.Path
 		return diags
 
 	case reflect.Interface:
-		diags.Append(flattener.interface_(ctx, vFrom, tTo, vTo)...)
+		diags.Append(flattener.interface_(ctx, sourcePath, vFrom, targetPath, tTo, vTo)...)
 		return diags
 	}
 
@@ -607,7 +614,7 @@ func (flattener autoFlattener) pointer(ctx context.Context, sourcePath path.Path
 	return diags
 }
 
-func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Value, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
+func (flattener autoFlattener) interface_(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, targetPath path.Path, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	switch tTo := tTo.(type) {
@@ -651,7 +658,7 @@ func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Val
 		//
 		// interface -> types.List(OfObject) or types.Object.
 		//
-		diags.Append(flattener.interfaceToNestedObject(ctx, vFrom, vFrom.IsNil(), tTo, vTo)...)
+		diags.Append(flattener.interfaceToNestedObject(ctx, sourcePath, vFrom, vFrom.IsNil(), targetPath, tTo, vTo)...)
 		return diags
 	}
 
@@ -1200,7 +1207,7 @@ func (flattener autoFlattener) structToNestedObject(ctx context.Context, sourceP
 }
 
 // interfaceToNestedObject copies an AWS API interface value to a compatible Plugin Framework NestedObjectValue value.
-func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFrom reflect.Value, isNullFrom bool, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
+func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, isNullFrom bool, targetPath path.Path, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	if isNullFrom {
@@ -1220,6 +1227,25 @@ func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFro
 		return diags
 	}
 
+	if t := vFrom.Elem().Type(); t.Kind() == reflect.Pointer && flattener.Options.isUnionMember(t.Elem()) {
+		//
+		// union member -> types.List(OfObject) or types.Object.
+		//
+		diags.Append(autoFlexConvertStruct(ctx, sourcePath, vFrom.Interface(), targetPath, to, flattener)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		val, d := tTo.ValueFromObjectPtr(ctx, to)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(val))
+		return diags
+	}
+
 	toFlattener, ok := to.(Flattener)
 	if !ok {
 		val, d := tTo.NullValue(ctx)
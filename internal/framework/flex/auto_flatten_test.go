@@ -4325,6 +4325,150 @@ func TestFlattenIgnoreStructTag(t *testing.T) {
 	runAutoExpandTestCases(t, testCases)
 }
 
+func TestFlattenFieldNameStructTag(t *testing.T) {
+	t.Parallel()
+
+	testCases := autoFlexTestCases{
+		"mapped fields": {
+			Source: &awsFieldNameTag{
+				Arn:         aws.String("value1"),
+				FieldName:   aws.String("value2"),
+				ResourceArn: aws.String("value3"),
+			},
+			Target: &tfFieldNameTag{},
+			WantTarget: &tfFieldNameTag{
+				Name: types.StringValue("value2"),
+				Arn:  types.StringValue("value3"),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[*awsFieldNameTag](), reflect.TypeFor[*tfFieldNameTag]()),
+				infoConverting(reflect.TypeFor[awsFieldNameTag](), reflect.TypeFor[*tfFieldNameTag]()),
+				debugNoCorrespondingField(reflect.TypeFor[awsFieldNameTag](), "Arn", reflect.TypeFor[*tfFieldNameTag]()),
+				traceMatchedFields("FieldName", reflect.TypeFor[awsFieldNameTag](), "Name", reflect.TypeFor[*tfFieldNameTag]()),
+				infoConvertingWithPath("FieldName", reflect.TypeFor[*string](), "Name", reflect.TypeFor[types.String]()),
+				traceMatchedFields("ResourceArn", reflect.TypeFor[awsFieldNameTag](), "Arn", reflect.TypeFor[*tfFieldNameTag]()),
+				infoConvertingWithPath("ResourceArn", reflect.TypeFor[*string](), "Arn", reflect.TypeFor[types.String]()),
+				debugUsingLegacyFlattener("ResourceArn", reflect.TypeFor[*string](), "Arn", reflect.TypeFor[types.String]()),
+			},
+		},
+	}
+
+	runAutoFlattenTestCases(t, testCases)
+}
+
+func TestFlattenConverter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testTimeTime := errs.Must(time.Parse(time.RFC3339, "2013-09-25T09:34:01Z"))
+
+	testCases := autoFlexTestCases{
+		"custom converters": {
+			Options: []AutoFlexOptionsFunc{
+				WithConverter(EpochSecondsToRFC3339),
+				WithConverter(CommaSeparatedStringToList),
+			},
+			Source: &awsConverter{
+				CreationTime: aws.Int64(testTimeTime.Unix()),
+				Values:       aws.String("a,b,c"),
+			},
+			Target: &tfConverter{},
+			WantTarget: &tfConverter{
+				CreationTime: timetypes.NewRFC3339TimeValue(testTimeTime),
+				Values:       FlattenFrameworkStringValueListOfString(ctx, []string{"a", "b", "c"}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[*awsConverter](), reflect.TypeFor[*tfConverter]()),
+				infoConverting(reflect.TypeFor[awsConverter](), reflect.TypeFor[*tfConverter]()),
+				traceMatchedFields("CreationTime", reflect.TypeFor[awsConverter](), "CreationTime", reflect.TypeFor[*tfConverter]()),
+				infoConvertingWithPath("CreationTime", reflect.TypeFor[*int64](), "CreationTime", reflect.TypeFor[timetypes.RFC3339]()),
+				infoUsingCustomConverter("CreationTime", reflect.TypeFor[*int64](), "CreationTime", reflect.TypeFor[timetypes.RFC3339]()),
+				traceMatchedFields("Values", reflect.TypeFor[awsConverter](), "Values", reflect.TypeFor[*tfConverter]()),
+				infoConvertingWithPath("Values", reflect.TypeFor[*string](), "Values", reflect.TypeFor[fwtypes.ListValueOf[types.String]]()),
+				infoUsingCustomConverter("Values", reflect.TypeFor[*string](), "Values", reflect.TypeFor[fwtypes.ListValueOf[types.String]]()),
+				// FlattenFrameworkStringValueList in CommaSeparatedStringToList()
+				infoFlatteningWithPath("Values", reflect.TypeFor[[]string](), "Values", reflect.TypeFor[*types.List]()), // TODO: fix path
+				infoConverting(reflect.TypeFor[[]string](), reflect.TypeFor[types.List]()),
+				traceFlatteningWithListValue("", reflect.TypeFor[[]string](), 3, "", reflect.TypeFor[types.List]()),
+			},
+		},
+		"nil values": {
+			Options: []AutoFlexOptionsFunc{
+				WithConverter(EpochSecondsToRFC3339),
+				WithConverter(CommaSeparatedStringToList),
+			},
+			Source: &awsConverter{},
+			Target: &tfConverter{},
+			WantTarget: &tfConverter{
+				CreationTime: timetypes.NewRFC3339Null(),
+				Values:       fwtypes.NewListValueOfNull[types.String](ctx),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[*awsConverter](), reflect.TypeFor[*tfConverter]()),
+				infoConverting(reflect.TypeFor[awsConverter](), reflect.TypeFor[*tfConverter]()),
+				traceMatchedFields("CreationTime", reflect.TypeFor[awsConverter](), "CreationTime", reflect.TypeFor[*tfConverter]()),
+				infoConvertingWithPath("CreationTime", reflect.TypeFor[*int64](), "CreationTime", reflect.TypeFor[timetypes.RFC3339]()),
+				infoUsingCustomConverter("CreationTime", reflect.TypeFor[*int64](), "CreationTime", reflect.TypeFor[timetypes.RFC3339]()),
+				traceMatchedFields("Values", reflect.TypeFor[awsConverter](), "Values", reflect.TypeFor[*tfConverter]()),
+				infoConvertingWithPath("Values", reflect.TypeFor[*string](), "Values", reflect.TypeFor[fwtypes.ListValueOf[types.String]]()),
+				infoUsingCustomConverter("Values", reflect.TypeFor[*string](), "Values", reflect.TypeFor[fwtypes.ListValueOf[types.String]]()),
+			},
+		},
+	}
+
+	runAutoFlattenTestCases(t, testCases)
+}
+
+func TestFlattenStrictFieldMatching(t *testing.T) {
+	t.Parallel()
+
+	testCases := autoFlexTestCases{
+		"unmatched": {
+			Options: []AutoFlexOptionsFunc{WithStrictFieldMatching()},
+			Source: &awsFieldNameTag{
+				Arn:         aws.String("value1"),
+				FieldName:   aws.String("value2"),
+				ResourceArn: aws.String("value3"),
+			},
+			Target: &tfFieldNameTag{},
+			expectedDiags: diag.Diagnostics{
+				diagConvertingNoCorrespondingField(reflect.TypeFor[awsFieldNameTag](), "Arn", reflect.TypeFor[tfFieldNameTag]()),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[*awsFieldNameTag](), reflect.TypeFor[*tfFieldNameTag]()),
+				infoConverting(reflect.TypeFor[awsFieldNameTag](), reflect.TypeFor[*tfFieldNameTag]()),
+				errorNoCorrespondingField(reflect.TypeFor[awsFieldNameTag](), "Arn", reflect.TypeFor[*tfFieldNameTag]()),
+			},
+		},
+		"ignored": {
+			Options: []AutoFlexOptionsFunc{WithStrictFieldMatching(), WithIgnoredFieldNamesAppend("Arn")},
+			Source: &awsFieldNameTag{
+				Arn:         aws.String("value1"),
+				FieldName:   aws.String("value2"),
+				ResourceArn: aws.String("value3"),
+			},
+			Target: &tfFieldNameTag{},
+			WantTarget: &tfFieldNameTag{
+				Name: types.StringValue("value2"),
+				Arn:  types.StringValue("value3"),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[*awsFieldNameTag](), reflect.TypeFor[*tfFieldNameTag]()),
+				infoConverting(reflect.TypeFor[awsFieldNameTag](), reflect.TypeFor[*tfFieldNameTag]()),
+				traceSkipIgnoredSourceField(reflect.TypeFor[awsFieldNameTag](), "Arn", reflect.TypeFor[*tfFieldNameTag]()),
+				traceMatchedFields("FieldName", reflect.TypeFor[awsFieldNameTag](), "Name", reflect.TypeFor[*tfFieldNameTag]()),
+				infoConvertingWithPath("FieldName", reflect.TypeFor[*string](), "Name", reflect.TypeFor[types.String]()),
+				traceMatchedFields("ResourceArn", reflect.TypeFor[awsFieldNameTag](), "Arn", reflect.TypeFor[*tfFieldNameTag]()),
+				infoConvertingWithPath("ResourceArn", reflect.TypeFor[*string](), "Arn", reflect.TypeFor[types.String]()),
+				debugUsingLegacyFlattener("ResourceArn", reflect.TypeFor[*string](), "Arn", reflect.TypeFor[types.String]()),
+			},
+		},
+	}
+
+	runAutoFlattenTestCases(t, testCases)
+}
+
 func TestFlattenInterfaceToStringTypable(t *testing.T) {
 	t.Parallel()
 
@@ -4792,6 +4936,163 @@ func TestFlattenInterface(t *testing.T) {
 	runAutoFlattenTestCases(t, testCases)
 }
 
+func TestFlattenUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"nested block member": {
+			Options: []AutoFlexOptionsFunc{WithUnionMembers(awsUnionMembers...)},
+			Source: &awsUnionSingle{
+				Field1: &awsUnionMemberNested{
+					Value: awsSingleStringValue{
+						Field1: "value1",
+					},
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Nested: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{
+								Field1: types.StringValue("value1"),
+							},
+						}),
+						Text:     types.StringNull(),
+						Location: types.StringNull(),
+					},
+				}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[*awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSingle](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[awsUnion](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				infoSourceIsUnionMember("Field1", reflect.TypeFor[awsUnionMemberNested](), "Field1", reflect.TypeFor[*tfUnion]()),
+				traceMatchedUnionMember("Field1", "awsUnionMemberNested", reflect.TypeFor[awsUnionMemberNested](), "Field1", "Nested", reflect.TypeFor[*tfUnion]()),
+				infoConvertingWithPath("Field1.Value", reflect.TypeFor[awsSingleStringValue](), "Field1.Nested", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfSingleStringField]]()),
+				traceMatchedFieldsWithPath("Field1.Value", "Field1", reflect.TypeFor[awsSingleStringValue](), "Field1.Nested", "Field1", reflect.TypeFor[*tfSingleStringField]()),
+				infoConvertingWithPath("Field1.Value.Field1", reflect.TypeFor[string](), "Field1.Nested.Field1", reflect.TypeFor[types.String]()),
+			},
+		},
+		"attribute member": {
+			Options: []AutoFlexOptionsFunc{WithUnionMembers(awsUnionMembers...)},
+			Source: &awsUnionSingle{
+				Field1: &awsUnionMemberText{
+					Value: "value1",
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Nested:   fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+						Text:     types.StringValue("value1"),
+						Location: types.StringNull(),
+					},
+				}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[*awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSingle](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[awsUnion](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				infoSourceIsUnionMember("Field1", reflect.TypeFor[awsUnionMemberText](), "Field1", reflect.TypeFor[*tfUnion]()),
+				traceMatchedUnionMember("Field1", "awsUnionMemberText", reflect.TypeFor[awsUnionMemberText](), "Field1", "Text", reflect.TypeFor[*tfUnion]()),
+				infoConvertingWithPath("Field1.Value", reflect.TypeFor[string](), "Field1.Text", reflect.TypeFor[types.String]()),
+			},
+		},
+		"struct tag member": {
+			Options: []AutoFlexOptionsFunc{WithUnionMembers(awsUnionMembers...)},
+			Source: &awsUnionSingle{
+				Field1: &awsUnionMemberUri{
+					Value: "value1",
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Nested:   fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+						Text:     types.StringNull(),
+						Location: types.StringValue("value1"),
+					},
+				}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[*awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSingle](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[awsUnion](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				infoSourceIsUnionMember("Field1", reflect.TypeFor[awsUnionMemberUri](), "Field1", reflect.TypeFor[*tfUnion]()),
+				traceMatchedUnionMember("Field1", "awsUnionMemberUri", reflect.TypeFor[awsUnionMemberUri](), "Field1", "Location", reflect.TypeFor[*tfUnion]()),
+				infoConvertingWithPath("Field1.Value", reflect.TypeFor[string](), "Field1.Location", reflect.TypeFor[types.String]()),
+			},
+		},
+		"nil union": {
+			Options: []AutoFlexOptionsFunc{WithUnionMembers(awsUnionMembers...)},
+			Source: &awsUnionSingle{
+				Field1: nil,
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfNull[tfUnion](ctx),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[*awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSingle](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[awsUnion](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+			},
+		},
+		"slice of unions": {
+			Options: []AutoFlexOptionsFunc{WithUnionMembers(awsUnionMembers...)},
+			Source: &awsUnionSlice{
+				Field1: []awsUnion{
+					&awsUnionMemberText{
+						Value: "value1",
+					},
+					&awsUnionMemberUri{
+						Value: "value2",
+					},
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Nested:   fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+						Text:     types.StringValue("value1"),
+						Location: types.StringNull(),
+					},
+					{
+						Nested:   fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+						Text:     types.StringNull(),
+						Location: types.StringValue("value2"),
+					},
+				}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[*awsUnionSlice](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSlice](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSlice](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[[]awsUnion](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				traceFlatteningNestedObjectCollection("Field1", reflect.TypeFor[[]awsUnion](), 2, "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				infoSourceIsUnionMember("Field1[0]", reflect.TypeFor[awsUnionMemberText](), "Field1[0]", reflect.TypeFor[*tfUnion]()),
+				traceMatchedUnionMember("Field1[0]", "awsUnionMemberText", reflect.TypeFor[awsUnionMemberText](), "Field1[0]", "Text", reflect.TypeFor[*tfUnion]()),
+				infoConvertingWithPath("Field1[0].Value", reflect.TypeFor[string](), "Field1[0].Text", reflect.TypeFor[types.String]()),
+				infoSourceIsUnionMember("Field1[1]", reflect.TypeFor[awsUnionMemberUri](), "Field1[1]", reflect.TypeFor[*tfUnion]()),
+				traceMatchedUnionMember("Field1[1]", "awsUnionMemberUri", reflect.TypeFor[awsUnionMemberUri](), "Field1[1]", "Location", reflect.TypeFor[*tfUnion]()),
+				infoConvertingWithPath("Field1[1].Value", reflect.TypeFor[string](), "Field1[1].Location", reflect.TypeFor[types.String]()),
+			},
+		},
+	}
+
+	runAutoFlattenTestCases(t, testCases)
+}
+
 func TestFlattenFlattener(t *testing.T) {
 	t.Parallel()
 
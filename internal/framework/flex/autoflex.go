@@ -102,6 +102,12 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 
 	// TODO: this only applies when Expanding
 	if valTo.Kind() == reflect.Interface {
+		if opts := flexer.getOptions(); len(opts.unionMembers(valTo.Type())) > 0 {
+			tflog.SubsystemInfo(ctx, subsystemName, "Target is union")
+			diags.Append(expandUnion(ctx, sourcePath, valFrom, targetPath, valTo, flexer)...)
+			return diags
+		}
+
 		tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]any{
 			"from": valFrom.Type(),
 			"to":   valTo.Kind(),
@@ -116,6 +122,13 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 		return diags
 	}
 
+	// TODO: this only applies when Flattening
+	if opts := flexer.getOptions(); opts.isUnionMember(valFrom.Type()) {
+		tflog.SubsystemInfo(ctx, subsystemName, "Source is union member")
+		diags.Append(flattenUnion(ctx, sourcePath, valFrom, targetPath, valTo, flexer)...)
+		return diags
+	}
+
 	typeFrom := valFrom.Type()
 	typeTo := valTo.Type()
 
@@ -147,9 +160,16 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 			continue
 		}
 
-		toField, ok := findFieldFuzzy(ctx, fieldName, typeFrom, typeTo, flexer)
+		toField, ok := findField(ctx, fromField, fromNameOverride, typeFrom, typeTo, flexer)
 		if !ok {
 			// Corresponding field not found in to.
+			if opts.strictFieldMatching {
+				tflog.SubsystemError(ctx, subsystemName, "No corresponding field", map[string]any{
+					logAttrKeySourceFieldname: fieldName,
+				})
+				diags.Append(diagConvertingNoCorrespondingField(typeFrom, fieldName, typeTo))
+				break
+			}
 			tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
 				logAttrKeySourceFieldname: fieldName,
 			})
@@ -201,6 +221,36 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 	return diags
 }
 
+// findField returns the field in typeTo corresponding to the field fromField in typeFrom.
+// A field name in the `autoflex` struct tag of either field takes precedence over fuzzy matching.
+func findField(ctx context.Context, fromField reflect.StructField, fromNameOverride string, typeFrom, typeTo reflect.Type, flexer autoFlexer) (reflect.StructField, bool) {
+	if fromNameOverride != "" {
+		return typeTo.FieldByName(fromNameOverride)
+	}
+
+	for i := 0; i < typeTo.NumField(); i++ {
+		field := typeTo.Field(i)
+		if !field.IsExported() {
+			continue // Skip unexported fields.
+		}
+		if toNameOverride, _ := autoflexTags(field); toNameOverride == fromField.Name {
+			return field, true
+		}
+	}
+
+	toField, ok := findFieldFuzzy(ctx, fromField.Name, typeFrom, typeTo, flexer)
+	if !ok {
+		return reflect.StructField{}, false
+	}
+
+	// A field explicitly mapped to a different field never fuzzy matches.
+	if toNameOverride, _ := autoflexTags(toField); toNameOverride != "" && toNameOverride != "-" {
+		return reflect.StructField{}, false
+	}
+
+	return toField, true
+}
+
 func findFieldFuzzy(ctx context.Context, fieldNameFrom string, typeFrom reflect.Type, typeTo reflect.Type, flexer autoFlexer) (reflect.StructField, bool) {
 	// first precedence is exact match (case sensitive)
 	if fieldTo, ok := typeTo.FieldByName(fieldNameFrom); ok {
@@ -317,6 +367,16 @@ func diagConvertingTargetIsNotPointer(targetType reflect.Type) diag.ErrorDiagnos
 	)
 }
 
+func diagConvertingNoCorrespondingField(sourceType reflect.Type, sourceFieldName string, targetType reflect.Type) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while converting configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Field %q of source type %q has no corresponding field in target type %q", sourceFieldName, fullTypeName(sourceType), fullTypeName(targetType)),
+	)
+}
+
 func valueType(v reflect.Value) reflect.Type {
 	if v.Kind() == reflect.Invalid {
 		return nil
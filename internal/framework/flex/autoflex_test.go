@@ -554,6 +554,69 @@ var _ awsInterfaceInterface = &awsInterfaceInterfaceImpl{}
 
 func (t *awsInterfaceInterfaceImpl) isAWSInterfaceInterface() {} // nosemgrep:ci.aws-in-func-name
 
+type tfFieldNameTag struct {
+	Name types.String `tfsdk:"name" autoflex:"FieldName"`
+	Arn  types.String `tfsdk:"arn" autoflex:"ResourceArn,legacy"`
+}
+
+type awsFieldNameTag struct {
+	Arn         *string
+	FieldName   *string
+	ResourceArn *string
+}
+
+type tfConverter struct {
+	CreationTime timetypes.RFC3339                 `tfsdk:"creation_time"`
+	Values       fwtypes.ListValueOf[types.String] `tfsdk:"values"`
+}
+
+type awsConverter struct {
+	CreationTime *int64
+	Values       *string
+}
+
+type tfUnion struct {
+	Nested   fwtypes.ListNestedObjectValueOf[tfSingleStringField] `tfsdk:"nested"`
+	Text     types.String                                         `tfsdk:"text"`
+	Location types.String                                         `tfsdk:"location" autoflex:",member=awsUnionMemberUri"`
+}
+
+type awsUnionSingle struct {
+	Field1 awsUnion
+}
+
+type awsUnionSlice struct {
+	Field1 []awsUnion
+}
+
+type awsUnion interface {
+	isAWSUnion()
+}
+
+type awsUnionMemberNested struct {
+	Value awsSingleStringValue
+}
+
+func (*awsUnionMemberNested) isAWSUnion() {} // nosemgrep:ci.aws-in-func-name
+
+type awsUnionMemberText struct {
+	Value string
+}
+
+func (*awsUnionMemberText) isAWSUnion() {} // nosemgrep:ci.aws-in-func-name
+
+type awsUnionMemberUri struct {
+	Value string
+}
+
+func (*awsUnionMemberUri) isAWSUnion() {} // nosemgrep:ci.aws-in-func-name
+
+var awsUnionMembers = []any{
+	&awsUnionMemberNested{},
+	&awsUnionMemberText{},
+	&awsUnionMemberUri{},
+}
+
 type tfFlexer struct {
 	Field1 types.String `tfsdk:"field1"`
 }
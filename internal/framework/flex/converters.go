@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// ConverterFunc converts a value of one type to a value of another type.
+type ConverterFunc[From, To any] func(context.Context, From) (To, diag.Diagnostics)
+
+type converterKey struct {
+	from, to reflect.Type
+}
+
+type converter func(context.Context, reflect.Value) (reflect.Value, diag.Diagnostics)
+
+// WithConverter registers a custom converter from values of type From to values of type To
+//
+// Use this option to convert between types that AutoFlex cannot convert, or to
+// override the default conversion. The converter is used for every field whose
+// source and target types exactly match From and To.
+// Register a converter for each direction to use it when both expanding and flattening.
+func WithConverter[From, To any](f ConverterFunc[From, To]) AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		if o.converters == nil {
+			o.converters = make(map[converterKey]converter)
+		}
+		key := converterKey{
+			from: reflect.TypeFor[From](),
+			to:   reflect.TypeFor[To](),
+		}
+		o.converters[key] = func(ctx context.Context, v reflect.Value) (reflect.Value, diag.Diagnostics) {
+			to, diags := f(ctx, v.Interface().(From))
+			return reflect.ValueOf(&to).Elem(), diags
+		}
+	}
+}
+
+// converter returns any custom converter registered for the specified source and target types.
+func (o *AutoFlexOptions) converter(from, to reflect.Type) (converter, bool) {
+	f, ok := o.converters[converterKey{from: from, to: to}]
+	return f, ok
+}
+
+// convertCustom converts a value using the specified custom converter.
+func convertCustom(ctx context.Context, f converter, vFrom, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.SubsystemInfo(ctx, subsystemName, "Using custom converter")
+
+	v, d := f(ctx, vFrom)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	vTo.Set(v)
+
+	return diags
+}
+
+// EpochSecondsToRFC3339 converts a Unix epoch seconds value to a Framework RFC3339 value.
+// A nil value is converted to a null RFC3339.
+func EpochSecondsToRFC3339(_ context.Context, v *int64) (timetypes.RFC3339, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v == nil {
+		return timetypes.NewRFC3339Null(), diags
+	}
+
+	return timetypes.NewRFC3339TimeValue(time.Unix(aws.ToInt64(v), 0).UTC()), diags
+}
+
+// RFC3339ToEpochSeconds converts a Framework RFC3339 value to a Unix epoch seconds value.
+// A null or unknown RFC3339 is converted to nil.
+func RFC3339ToEpochSeconds(_ context.Context, v timetypes.RFC3339) (*int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() || v.IsUnknown() {
+		return nil, diags
+	}
+
+	t, d := v.ValueRFC3339Time()
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return aws.Int64(t.Unix()), diags
+}
+
+// CommaSeparatedStringToList converts a comma-separated string value to a Framework List value.
+// A nil or empty string is converted to a null List.
+func CommaSeparatedStringToList(ctx context.Context, v *string) (fwtypes.ListValueOf[types.String], diag.Diagnostics) {
+	if aws.ToString(v) == "" {
+		return fwtypes.NewListValueOfNull[types.String](ctx), nil
+	}
+
+	return FlattenFrameworkStringValueListOfString(ctx, strings.Split(aws.ToString(v), ",")), nil
+}
+
+// ListToCommaSeparatedString converts a Framework List value to a comma-separated string value.
+// A null or unknown List is converted to nil.
+func ListToCommaSeparatedString(ctx context.Context, v fwtypes.ListValueOf[types.String]) (*string, diag.Diagnostics) {
+	if v.IsNull() || v.IsUnknown() {
+		return nil, nil
+	}
+
+	return aws.String(strings.Join(ExpandFrameworkStringValueList(ctx, v), ",")), nil
+}
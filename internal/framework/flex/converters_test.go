@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+func TestEpochSecondsToRFC3339(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input    *int64
+		expected timetypes.RFC3339
+	}
+	tests := map[string]testCase{
+		"valid time": {
+			input:    aws.Int64(1690317796),
+			expected: timetypes.NewRFC3339ValueMust("2023-07-25T20:43:16Z"),
+		},
+		"nil time": {
+			input:    nil,
+			expected: timetypes.NewRFC3339Null(),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := flex.EpochSecondsToRFC3339(context.Background(), test.input)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if diff := cmp.Diff(got, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRFC3339ToEpochSeconds(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input    timetypes.RFC3339
+		expected *int64
+	}
+	tests := map[string]testCase{
+		"valid time": {
+			input:    timetypes.NewRFC3339ValueMust("2023-07-25T20:43:16Z"),
+			expected: aws.Int64(1690317796),
+		},
+		"null time": {
+			input:    timetypes.NewRFC3339Null(),
+			expected: nil,
+		},
+		"unknown time": {
+			input:    timetypes.NewRFC3339Unknown(),
+			expected: nil,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := flex.RFC3339ToEpochSeconds(context.Background(), test.input)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if diff := cmp.Diff(got, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestCommaSeparatedStringToList(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	type testCase struct {
+		input    *string
+		expected fwtypes.ListValueOf[types.String]
+	}
+	tests := map[string]testCase{
+		"two elements": {
+			input: aws.String("GET,HEAD"),
+			expected: fwtypes.NewListValueOfMust[types.String](ctx, []attr.Value{
+				types.StringValue("GET"),
+				types.StringValue("HEAD"),
+			}),
+		},
+		"empty string": {
+			input:    aws.String(""),
+			expected: fwtypes.NewListValueOfNull[types.String](ctx),
+		},
+		"nil string": {
+			input:    nil,
+			expected: fwtypes.NewListValueOfNull[types.String](ctx),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := flex.CommaSeparatedStringToList(ctx, test.input)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if diff := cmp.Diff(got, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestListToCommaSeparatedString(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	type testCase struct {
+		input    fwtypes.ListValueOf[types.String]
+		expected *string
+	}
+	tests := map[string]testCase{
+		"two elements": {
+			input: fwtypes.NewListValueOfMust[types.String](ctx, []attr.Value{
+				types.StringValue("GET"),
+				types.StringValue("HEAD"),
+			}),
+			expected: aws.String("GET,HEAD"),
+		},
+		"null list": {
+			input:    fwtypes.NewListValueOfNull[types.String](ctx),
+			expected: nil,
+		},
+		"unknown list": {
+			input:    fwtypes.NewListValueOfUnknown[types.String](ctx),
+			expected: nil,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := flex.ListToCommaSeparatedString(ctx, test.input)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if diff := cmp.Diff(got, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
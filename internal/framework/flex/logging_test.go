@@ -269,6 +269,23 @@ func debugNoCorrespondingField(sourceType reflect.Type, sourceFieldName string,
 	}
 }
 
+func errorNoCorrespondingField(sourceType reflect.Type, sourceFieldName string, targetType reflect.Type) map[string]any {
+	return map[string]any{
+		"@level":                  hclog.Error.String(),
+		"@module":                 logModule,
+		"@message":                "No corresponding field",
+		logAttrKeySourcePath:      "",
+		logAttrKeySourceType:      fullTypeName(sourceType),
+		logAttrKeySourceFieldname: sourceFieldName,
+		logAttrKeyTargetPath:      "",
+		logAttrKeyTargetType:      fullTypeName(targetType),
+	}
+}
+
+func infoUsingCustomConverter(sourcePath string, sourceType reflect.Type, targetPath string, targetType reflect.Type) map[string]any {
+	return infoWithPathLogLine("Using custom converter", sourcePath, sourceType, targetPath, targetType)
+}
+
 func traceExpandingNullValue(sourcePath string, sourceType reflect.Type, targetPath string, targetType reflect.Type) map[string]any {
 	return map[string]any{
 		"@level":             hclog.Trace.String(),
@@ -493,6 +510,53 @@ func infoSourceImplementsJSONStringer(sourcePath string, sourceType reflect.Type
 	}
 }
 
+func infoTargetIsUnion(sourcePath string, sourceType reflect.Type, targetPath string, targetType reflect.Type) map[string]any {
+	return infoWithPathLogLine("Target is union", sourcePath, sourceType, targetPath, targetType)
+}
+
+func infoSourceIsUnionMember(sourcePath string, sourceType reflect.Type, targetPath string, targetType reflect.Type) map[string]any {
+	return infoWithPathLogLine("Source is union member", sourcePath, sourceType, targetPath, targetType)
+}
+
+func traceMatchedUnionMember(sourcePath, sourceFieldName string, sourceType reflect.Type, targetPath, targetFieldName string, targetType reflect.Type) map[string]any {
+	return map[string]any{
+		"@level":                  hclog.Trace.String(),
+		"@module":                 logModule,
+		"@message":                "Matched union member",
+		logAttrKeySourcePath:      sourcePath,
+		logAttrKeySourceType:      fullTypeName(sourceType),
+		logAttrKeySourceFieldname: sourceFieldName,
+		logAttrKeyTargetPath:      targetPath,
+		logAttrKeyTargetType:      fullTypeName(targetType),
+		logAttrKeyTargetFieldname: targetFieldName,
+	}
+}
+
+func debugNoUnionMember(sourcePath string, sourceType reflect.Type, targetPath string, targetType reflect.Type) map[string]any {
+	return map[string]any{
+		"@level":             hclog.Debug.String(),
+		"@module":            logModule,
+		"@message":           "No union member configured",
+		logAttrKeySourcePath: sourcePath,
+		logAttrKeySourceType: fullTypeName(sourceType),
+		logAttrKeyTargetPath: targetPath,
+		logAttrKeyTargetType: fullTypeName(targetType),
+	}
+}
+
+func errorMultipleUnionMembers(sourcePath string, sourceType reflect.Type, sourceFieldName string, targetPath string, targetType reflect.Type) map[string]any {
+	return map[string]any{
+		"@level":                  hclog.Error.String(),
+		"@module":                 logModule,
+		"@message":                "Multiple union members configured",
+		logAttrKeySourcePath:      sourcePath,
+		logAttrKeySourceType:      fullTypeName(sourceType),
+		logAttrKeySourceFieldname: sourceFieldName,
+		logAttrKeyTargetPath:      targetPath,
+		logAttrKeyTargetType:      fullTypeName(targetType),
+	}
+}
+
 func errorSourceDoesNotImplementAttrValue(sourcePath string, sourceType reflect.Type, targetPath string, targetType reflect.Type) map[string]any {
 	return map[string]any{
 		"@level":             hclog.Error.String(),
@@ -3,6 +3,12 @@
 
 package flex
 
+import (
+	"reflect"
+
+	smithyjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+)
+
 var (
 	DefaultIgnoredFieldNames = []string{
 		"Tags", // Resource tags are handled separately.
@@ -25,6 +31,21 @@ type AutoFlexOptions struct {
 	// ignoredFieldNames stores names which expanders and flatteners will
 	// not read from or write to
 	ignoredFieldNames []string
+
+	// unionMemberTypes stores the AWS API union member struct types which
+	// expanders and flatteners map to and from nested blocks
+	unionMemberTypes []reflect.Type
+
+	// smithyDocumentFactory creates the AWS API Smithy document for a
+	// decoded JSON value
+	smithyDocumentFactory func(any) smithyjson.JSONStringer
+
+	// converters stores custom converters keyed by source and target type
+	converters map[converterKey]converter
+
+	// strictFieldMatching specifies whether a source field without a
+	// corresponding target field is an error
+	strictFieldMatching bool
 }
 
 // WithFieldNamePrefix specifies a prefix to be accounted for when
@@ -75,6 +96,50 @@ func WithNoIgnoredFieldNames() AutoFlexOptionsFunc {
 	}
 }
 
+// WithUnionMembers registers AWS API union member types, e.g. `&awstypes.ConfigurationMemberS3{}`
+//
+// Use this option to expand nested blocks to, and flatten nested blocks from,
+// AWS API union interface values. Each member type is matched to the struct
+// field named after the text following "Member" in the type's name, or to the
+// field with a `member` struct tag option naming the type.
+func WithUnionMembers(members ...any) AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		for _, v := range members {
+			t := reflect.TypeOf(v)
+			if t.Kind() == reflect.Pointer {
+				t = t.Elem()
+			}
+			o.unionMemberTypes = append(o.unionMemberTypes, t)
+		}
+	}
+}
+
+// WithSmithyDocumentFactory specifies the function used to create AWS API
+// Smithy documents, e.g. `document.NewLazyDocument`
+//
+// Use this option to expand JSON strings held in String-ish values other than
+// fwtypes.SmithyJSON to Smithy document interface values.
+func WithSmithyDocumentFactory[T smithyjson.JSONStringer](f func(any) T) AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		o.smithyDocumentFactory = func(v any) smithyjson.JSONStringer {
+			return f(v)
+		}
+	}
+}
+
+// WithStrictFieldMatching specifies that every source field must have a
+// corresponding target field
+//
+// Use this option, typically in unit tests, to catch mismatches between
+// Terraform and AWS data structures that would otherwise be silently dropped.
+// Fields that are deliberately unmatched can be excluded with the ignored
+// field names options or the `-` struct tag.
+func WithStrictFieldMatching() AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		o.strictFieldMatching = true
+	}
+}
+
 // isIgnoredField returns true if s is in the list of ignored field names
 func (o *AutoFlexOptions) isIgnoredField(s string) bool {
 	for _, name := range o.ignoredFieldNames {
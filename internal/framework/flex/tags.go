@@ -49,3 +49,17 @@ func (o tagOptions) OmitEmpty() bool {
 func (o tagOptions) NoFlatten() bool {
 	return o.Contains("noflatten")
 }
+
+// Member returns the value of the "member" option, the name of the AWS API union member type
+// corresponding to the field, or the empty string.
+func (o tagOptions) Member() string {
+	s := string(o)
+	for s != "" {
+		var name string
+		name, s, _ = strings.Cut(s, ",")
+		if v, ok := strings.CutPrefix(name, "member="); ok {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// AWS SDK for Go v2 union types are modelled as an interface (e.g. `types.Configuration`)
+// implemented by one "member" struct per union alternative (e.g. `types.ConfigurationMemberS3`).
+// Each member struct has a single `Value` field holding the alternative's data.
+//
+// In Terraform a union is modelled as a nested block with one attribute or nested block per
+// alternative, exactly one of which is configured. The alternative is matched to a member by
+// the text following "Member" in the member type's name (e.g. `S3`), or explicitly via a
+// `member` struct tag option holding the member type's name, e.g.
+//
+//	S3Location fwtypes.ListNestedObjectValueOf[s3LocationModel] `tfsdk:"s3_location" autoflex:",member=ConfigurationMemberS3"`
+//
+// Member types must be registered with the WithUnionMembers option.
+
+const (
+	unionMemberMarker    = "Member"
+	unionMemberValueName = "Value"
+)
+
+// unionMemberName returns the name of the union alternative implemented by the specified member type.
+func unionMemberName(t reflect.Type) string {
+	name := t.Name()
+	if i := strings.LastIndex(name, unionMemberMarker); i >= 0 {
+		return name[i+len(unionMemberMarker):]
+	}
+	return name
+}
+
+// unionMembers returns the registered member types that implement the specified union interface.
+func (o *AutoFlexOptions) unionMembers(tUnion reflect.Type) []reflect.Type {
+	var members []reflect.Type
+
+	for _, t := range o.unionMemberTypes {
+		if reflect.PointerTo(t).Implements(tUnion) {
+			members = append(members, t)
+		}
+	}
+
+	return members
+}
+
+// isUnionMember returns true if the specified type is a registered union member type.
+func (o *AutoFlexOptions) isUnionMember(t reflect.Type) bool {
+	for _, v := range o.unionMemberTypes {
+		if v == t {
+			return true
+		}
+	}
+	return false
+}
+
+// unionMemberField returns the field of the Terraform struct type corresponding to the specified union member type.
+func unionMemberField(ctx context.Context, typeModel, tMember reflect.Type, flexer autoFlexer) (reflect.StructField, bool) {
+	for i := 0; i < typeModel.NumField(); i++ {
+		field := typeModel.Field(i)
+		if !field.IsExported() {
+			continue // Skip unexported fields.
+		}
+		if _, opts := autoflexTags(field); opts.Member() == tMember.Name() {
+			return field, true
+		}
+	}
+
+	field, ok := findFieldFuzzy(ctx, unionMemberName(tMember), tMember, typeModel, flexer)
+	if !ok {
+		return reflect.StructField{}, false
+	}
+	if _, opts := autoflexTags(field); opts.Member() != "" {
+		// The field is explicitly mapped to a different member.
+		return reflect.StructField{}, false
+	}
+
+	return field, true
+}
+
+// expandUnion expands a Plugin Framework struct value into the AWS API union member corresponding to the configured field.
+func expandUnion(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, targetPath path.Path, valTo reflect.Value, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	opts := flexer.getOptions()
+	typeFrom := valFrom.Type()
+	tUnion := valTo.Type()
+
+	var member reflect.Value
+	for _, tMember := range opts.unionMembers(tUnion) {
+		field, ok := unionMemberField(ctx, typeFrom, tMember, flexer)
+		if !ok {
+			continue
+		}
+
+		fieldVal := valFrom.FieldByIndex(field.Index)
+		if !unionMemberIsSet(fieldVal) {
+			continue
+		}
+
+		if member.IsValid() {
+			tflog.SubsystemError(ctx, subsystemName, "Multiple union members configured", map[string]any{
+				logAttrKeySourceFieldname: field.Name,
+			})
+			diags.Append(diagExpandingMultipleUnionMembers(typeFrom, tUnion))
+			return diags
+		}
+
+		tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+			logAttrKeySourceFieldname: field.Name,
+			logAttrKeyTargetFieldname: tMember.Name(),
+		})
+
+		member = reflect.New(tMember)
+		diags.Append(flexer.convert(ctx, sourcePath.AtName(field.Name), fieldVal, targetPath.AtName(unionMemberValueName), member.Elem().FieldByName(unionMemberValueName), fieldOpts{})...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if !member.IsValid() {
+		tflog.SubsystemDebug(ctx, subsystemName, "No union member configured")
+		return diags
+	}
+
+	valTo.Set(member)
+
+	return diags
+}
+
+// unionMemberIsSet returns whether the specified Plugin Framework value is configured.
+func unionMemberIsSet(v reflect.Value) bool {
+	val, ok := v.Interface().(attr.Value)
+	if !ok || val.IsNull() || val.IsUnknown() {
+		return false
+	}
+
+	if val, ok := val.(valueWithElementsAs); ok {
+		return len(val.Elements()) > 0
+	}
+
+	return true
+}
+
+// flattenUnion flattens an AWS API union member value into the corresponding field of a Plugin Framework struct value.
+func flattenUnion(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, targetPath path.Path, valTo reflect.Value, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// All alternatives other than the matched member are null.
+	diags.Append(flattenPrePopulate(ctx, valTo)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	tMember := valFrom.Type()
+	field, ok := unionMemberField(ctx, valTo.Type(), tMember, flexer)
+	if !ok {
+		tflog.SubsystemError(ctx, subsystemName, "No corresponding union member field", map[string]any{
+			logAttrKeySourceFieldname: tMember.Name(),
+		})
+		diags.Append(diagFlatteningIncompatibleTypes(tMember, valTo.Type()))
+		return diags
+	}
+
+	tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+		logAttrKeySourceFieldname: tMember.Name(),
+		logAttrKeyTargetFieldname: field.Name,
+	})
+
+	diags.Append(flexer.convert(ctx, sourcePath.AtName(unionMemberValueName), valFrom.FieldByName(unionMemberValueName), targetPath.AtName(field.Name), valTo.FieldByIndex(field.Index), fieldOpts{})...)
+
+	return diags
+}
+
+func diagExpandingMultipleUnionMembers(sourceType, targetType reflect.Type) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while expanding configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Source type %q has more than one member of union %q configured.", fullTypeName(sourceType), fullTypeName(targetType)),
+	)
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// SDKv2StateUpgradeFunc is the signature of a Plugin SDK V2 state upgrade function (schema.StateUpgradeFunc).
+type SDKv2StateUpgradeFunc = func(context.Context, map[string]any, any) (map[string]any, error)
+
+// SDKv2StateUpgrader returns a state upgrader that upgrades raw JSON state written by a Plugin SDK V2 resource
+// by applying the resource's Plugin SDK V2 state upgrade functions, in order.
+// Use it to preserve a resource's existing state upgrades when migrating the resource to Plugin Framework.
+// The upgraded state must conform to the resource's current Plugin Framework schema.
+func SDKv2StateUpgrader(meta any, upgraders ...SDKv2StateUpgradeFunc) resource.StateUpgrader {
+	return resource.StateUpgrader{
+		StateUpgrader: func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+			if request.RawState == nil || request.RawState.JSON == nil {
+				response.Diagnostics.AddError("upgrading Plugin SDK V2 state", "raw JSON state is required")
+
+				return
+			}
+
+			var rawState map[string]any
+			if err := json.Unmarshal(request.RawState.JSON, &rawState); err != nil {
+				response.Diagnostics.AddError("upgrading Plugin SDK V2 state", err.Error())
+
+				return
+			}
+
+			for _, upgrader := range upgraders {
+				var err error
+				rawState, err = upgrader(ctx, rawState, meta)
+
+				if err != nil {
+					response.Diagnostics.AddError("upgrading Plugin SDK V2 state", err.Error())
+
+					return
+				}
+			}
+
+			v, err := json.Marshal(rawState)
+
+			if err != nil {
+				response.Diagnostics.AddError("upgrading Plugin SDK V2 state", err.Error())
+
+				return
+			}
+
+			response.DynamicValue = &tfprotov6.DynamicValue{
+				JSON: v,
+			}
+		},
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func TestSDKv2StateUpgrader(t *testing.T) {
+	t.Parallel()
+
+	upgradeV0 := func(_ context.Context, rawState map[string]any, _ any) (map[string]any, error) {
+		rawState["name"] = rawState["old_name"]
+		delete(rawState, "old_name")
+		return rawState, nil
+	}
+	upgradeV1 := func(_ context.Context, rawState map[string]any, _ any) (map[string]any, error) {
+		rawState["enabled"] = true
+		return rawState, nil
+	}
+	upgradeError := func(context.Context, map[string]any, any) (map[string]any, error) {
+		return nil, errors.New("test error")
+	}
+
+	testCases := map[string]struct {
+		rawState    *tfprotov6.RawState
+		upgraders   []SDKv2StateUpgradeFunc
+		expected    string
+		expectError bool
+	}{
+		"no raw state": {
+			expectError: true,
+		},
+		"flatmap raw state": {
+			rawState:    &tfprotov6.RawState{Flatmap: map[string]string{"id": "example"}},
+			expectError: true,
+		},
+		"no upgraders": {
+			rawState: &tfprotov6.RawState{JSON: []byte(`{"id":"example"}`)},
+			expected: `{"id":"example"}`,
+		},
+		"upgraders applied in order": {
+			rawState:  &tfprotov6.RawState{JSON: []byte(`{"id":"example","old_name":"test"}`)},
+			upgraders: []SDKv2StateUpgradeFunc{upgradeV0, upgradeV1},
+			expected:  `{"enabled":true,"id":"example","name":"test"}`,
+		},
+		"upgrader error": {
+			rawState:    &tfprotov6.RawState{JSON: []byte(`{"id":"example"}`)},
+			upgraders:   []SDKv2StateUpgradeFunc{upgradeV1, upgradeError},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			upgrader := SDKv2StateUpgrader(nil, testCase.upgraders...)
+			request := resource.UpgradeStateRequest{RawState: testCase.rawState}
+			response := resource.UpgradeStateResponse{}
+
+			upgrader.StateUpgrader(context.Background(), request, &response)
+
+			if got, want := response.Diagnostics.HasError(), testCase.expectError; got != want {
+				t.Fatalf("HasError = %t, want %t: %v", got, want, response.Diagnostics)
+			}
+
+			if testCase.expectError {
+				return
+			}
+
+			if diff := cmp.Diff(string(response.DynamicValue.JSON), testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
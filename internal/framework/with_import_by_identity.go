@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// IdentityAttribute describes a top-level resource attribute that is part of the resource's identity.
+type IdentityAttribute struct {
+	// Name is the attribute name, also used as the key in a structured import ID.
+	Name string
+	// Optional indicates that the attribute may be omitted from an import ID.
+	Optional bool
+}
+
+// WithImportByIdentity is intended to be embedded in resources which import state via structured identity attributes.
+//
+// The import ID is either a JSON object whose keys are identity attribute names, for example
+//
+//	import {
+//	  to = aws_ebs_fast_snapshot_restore.example
+//	  id = jsonencode({
+//	    availability_zone = "us-west-2a"
+//	    snapshot_id       = "snap-0123456789abcdef0"
+//	  })
+//	}
+//
+// or, for backwards compatibility, the identity attribute values in declaration order separated by flex.ResourceIdSeparator.
+// A JSON object may also contain "account_id" and "region" keys which must match the provider configuration.
+//
+// If the resource has an "id" attribute it is set to the identity attribute values separated by flex.ResourceIdSeparator,
+// or to the single identity attribute value.
+type WithImportByIdentity struct {
+	identity []IdentityAttribute
+}
+
+// SetIdentity sets the resource's identity attributes.
+// Attribute order determines the order of values in the legacy import ID format.
+func (w *WithImportByIdentity) SetIdentity(attributes ...IdentityAttribute) {
+	w.identity = attributes
+}
+
+// Identity returns the resource's identity attributes.
+func (w *WithImportByIdentity) Identity() []IdentityAttribute {
+	return w.identity
+}
+
+func (w *WithImportByIdentity) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	values, err := ParseImportID(request.ID, w.identity)
+
+	if err != nil {
+		response.Diagnostics.AddError("parsing import ID", err.Error())
+
+		return
+	}
+
+	if inContext, ok := conns.FromContext(ctx); ok && inContext.Operation == "Import" {
+		if v, ok := values[names.AttrAccountID]; ok && !w.hasAttribute(names.AttrAccountID) && inContext.AccountID != "" && v != inContext.AccountID {
+			response.Diagnostics.AddError("parsing import ID", fmt.Sprintf("account_id (%s) does not match the provider's account ID (%s)", v, inContext.AccountID))
+		}
+		if v, ok := values[names.AttrRegion]; ok && !w.hasAttribute(names.AttrRegion) && inContext.Region != "" && v != inContext.Region {
+			response.Diagnostics.AddError("parsing import ID", fmt.Sprintf("region (%s) does not match the provider's region (%s)", v, inContext.Region))
+		}
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	parts := make([]string, 0, len(w.identity))
+	for _, attribute := range w.identity {
+		v, ok := values[attribute.Name]
+		parts = append(parts, v)
+
+		if !ok {
+			continue
+		}
+
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(attribute.Name), v)...)
+	}
+
+	if _, diags := response.State.Schema.TypeAtPath(ctx, path.Root(names.AttrID)); !diags.HasError() && !w.hasAttribute(names.AttrID) {
+		id := strings.Join(parts, flex.ResourceIdSeparator)
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), id)...)
+	}
+}
+
+func (w *WithImportByIdentity) hasAttribute(name string) bool {
+	return slices.ContainsFunc(w.identity, func(v IdentityAttribute) bool {
+		return v.Name == name
+	})
+}
+
+// ParseImportID parses an import ID into identity attribute values.
+// See WithImportByIdentity for the accepted formats.
+func ParseImportID(id string, identity []IdentityAttribute) (map[string]string, error) {
+	if len(identity) == 0 {
+		return nil, fmt.Errorf("resource declares no identity attributes")
+	}
+
+	if strings.HasPrefix(strings.TrimSpace(id), "{") {
+		return parseImportIDObject(id, identity)
+	}
+
+	values := make(map[string]string, len(identity))
+
+	if len(identity) == 1 {
+		if id == "" && !identity[0].Optional {
+			return nil, fmt.Errorf("unexpected format for import ID (%s), expected %s", id, identity[0].Name)
+		}
+
+		values[identity[0].Name] = id
+
+		return values, nil
+	}
+
+	allowEmptyPart := slices.ContainsFunc(identity, func(v IdentityAttribute) bool {
+		return v.Optional
+	})
+	parts, err := flex.ExpandResourceId(id, len(identity), allowEmptyPart)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for i, attribute := range identity {
+		if parts[i] == "" && !attribute.Optional {
+			return nil, fmt.Errorf("unexpected format for import ID (%s), %s must not be empty", id, attribute.Name)
+		}
+
+		values[attribute.Name] = parts[i]
+	}
+
+	return values, nil
+}
+
+func parseImportIDObject(id string, identity []IdentityAttribute) (map[string]string, error) {
+	var object map[string]string
+
+	if err := json.Unmarshal([]byte(id), &object); err != nil {
+		return nil, fmt.Errorf("unexpected format for import ID (%s), expected a JSON object with string values: %w", id, err)
+	}
+
+	keys := []string{names.AttrAccountID, names.AttrRegion}
+	for _, attribute := range identity {
+		keys = append(keys, attribute.Name)
+	}
+
+	for k := range object {
+		if !slices.Contains(keys, k) {
+			return nil, fmt.Errorf("unexpected attribute (%s) in import ID, expected one of %s", k, strings.Join(keys, ", "))
+		}
+	}
+
+	for _, attribute := range identity {
+		if v, ok := object[attribute.Name]; (!ok || v == "") && !attribute.Optional {
+			return nil, fmt.Errorf("missing required attribute (%s) in import ID", attribute.Name)
+		}
+	}
+
+	return object, nil
+}
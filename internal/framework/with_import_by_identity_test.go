@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestParseImportID(t *testing.T) {
+	t.Parallel()
+
+	single := []IdentityAttribute{
+		{Name: "name"},
+	}
+	multiple := []IdentityAttribute{
+		{Name: "availability_zone"},
+		{Name: "snapshot_id"},
+	}
+	withOptional := []IdentityAttribute{
+		{Name: "name"},
+		{Name: "catalog_id", Optional: true},
+	}
+
+	testCases := map[string]struct {
+		id          string
+		identity    []IdentityAttribute
+		expected    map[string]string
+		expectError bool
+	}{
+		"no identity": {
+			id:          "example",
+			expectError: true,
+		},
+		"single legacy": {
+			id:       "example",
+			identity: single,
+			expected: map[string]string{"name": "example"},
+		},
+		"single legacy empty": {
+			id:          "",
+			identity:    single,
+			expectError: true,
+		},
+		"multiple legacy": {
+			id:       "us-west-2a,snap-12345678",
+			identity: multiple,
+			expected: map[string]string{"availability_zone": "us-west-2a", "snapshot_id": "snap-12345678"},
+		},
+		"multiple legacy wrong part count": {
+			id:          "us-west-2a,snap-12345678,extra",
+			identity:    multiple,
+			expectError: true,
+		},
+		"multiple legacy empty part": {
+			id:          "us-west-2a,",
+			identity:    multiple,
+			expectError: true,
+		},
+		"optional legacy empty part": {
+			id:       "example,",
+			identity: withOptional,
+			expected: map[string]string{"name": "example", "catalog_id": ""},
+		},
+		"optional legacy empty required part": {
+			id:          ",123456789012",
+			identity:    withOptional,
+			expectError: true,
+		},
+		"multiple object": {
+			id:       `{"snapshot_id":"snap-12345678","availability_zone":"us-west-2a"}`,
+			identity: multiple,
+			expected: map[string]string{"availability_zone": "us-west-2a", "snapshot_id": "snap-12345678"},
+		},
+		"object with account and region": {
+			id:       ` {"name":"example","account_id":"123456789012","region":"us-west-2"}`,
+			identity: single,
+			expected: map[string]string{"name": "example", "account_id": "123456789012", "region": "us-west-2"},
+		},
+		"object missing required attribute": {
+			id:          `{"availability_zone":"us-west-2a"}`,
+			identity:    multiple,
+			expectError: true,
+		},
+		"object missing optional attribute": {
+			id:       `{"name":"example"}`,
+			identity: withOptional,
+			expected: map[string]string{"name": "example"},
+		},
+		"object unexpected attribute": {
+			id:          `{"name":"example","arn":"arn:aws:s3:::example"}`,
+			identity:    single,
+			expectError: true,
+		},
+		"object invalid JSON": {
+			id:          `{"name":}`,
+			identity:    single,
+			expectError: true,
+		},
+		"object non-string value": {
+			id:          `{"name":1}`,
+			identity:    single,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseImportID(testCase.id, testCase.identity)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("ParseImportID(%q) err %t, want %t (%v)", testCase.id, got, want, err)
+			}
+
+			if err == nil {
+				if diff := cmp.Diff(got, testCase.expected); diff != "" {
+					t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestWithImportByIdentityImportState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"rule_id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		ctx         context.Context
+		id          string
+		expectError bool
+	}{
+		"legacy": {
+			ctx: conns.NewImportContext(conns.NewResourceContext(ctx, "ec2", "Rule", "aws_rule"), "123456789012", "us-west-2"),
+			id:  "sgr-1",
+		},
+		"object matching region": {
+			ctx: conns.NewImportContext(conns.NewResourceContext(ctx, "ec2", "Rule", "aws_rule"), "123456789012", "us-west-2"),
+			id:  `{"region":"us-west-2","rule_id":"sgr-1"}`,
+		},
+		"object other region": {
+			ctx:         conns.NewImportContext(conns.NewResourceContext(ctx, "ec2", "Rule", "aws_rule"), "123456789012", "us-west-2"),
+			id:          `{"region":"us-east-1","rule_id":"sgr-1"}`,
+			expectError: true,
+		},
+		"object other account": {
+			ctx:         conns.NewImportContext(conns.NewResourceContext(ctx, "ec2", "Rule", "aws_rule"), "123456789012", "us-west-2"),
+			id:          `{"account_id":"210987654321","rule_id":"sgr-1"}`,
+			expectError: true,
+		},
+		"object not importing": {
+			ctx: conns.NewResourceContext(ctx, "ec2", "Rule", "aws_rule"),
+			id:  `{"region":"us-east-1","rule_id":"sgr-1"}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var w WithImportByIdentity
+			w.SetIdentity(IdentityAttribute{Name: "rule_id"})
+
+			request := resource.ImportStateRequest{ID: testCase.id}
+			response := resource.ImportStateResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(testSchema.Type().TerraformType(ctx), nil),
+					Schema: testSchema,
+				},
+			}
+			w.ImportState(testCase.ctx, request, &response)
+
+			if got, want := response.Diagnostics.HasError(), testCase.expectError; got != want {
+				t.Fatalf("ImportState(%q) error %t, want %t: %v", testCase.id, got, want, response.Diagnostics)
+			}
+
+			if testCase.expectError {
+				return
+			}
+
+			for _, attribute := range []string{"id", "rule_id"} {
+				var got string
+				response.Diagnostics.Append(response.State.GetAttribute(ctx, path.Root(attribute), &got)...)
+
+				if response.Diagnostics.HasError() {
+					t.Fatalf("GetAttribute(%s): %v", attribute, response.Diagnostics)
+				}
+
+				if want := "sgr-1"; got != want {
+					t.Errorf("%s = %q, want %q", attribute, got, want)
+				}
+			}
+		})
+	}
+}
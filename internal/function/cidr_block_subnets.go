@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+var _ function.Function = cidrBlockSubnetsFunction{}
+
+func NewCIDRBlockSubnetsFunction() function.Function {
+	return &cidrBlockSubnetsFunction{}
+}
+
+type cidrBlockSubnetsFunction struct{}
+
+func (f cidrBlockSubnetsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_block_subnets"
+}
+
+func (f cidrBlockSubnetsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_block_subnets Function",
+		MarkdownDescription: "Divides an IPv4 or IPv6 CIDR block into all of its subnets of equal size. " +
+			"Each subnet's prefix length is the CIDR block's prefix length extended by `newbits`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr_block",
+				MarkdownDescription: "CIDR block to divide",
+			},
+			function.Int64Parameter{
+				Name:                "newbits",
+				MarkdownDescription: "Number of additional bits with which to extend the prefix, between 0 and 16",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f cidrBlockSubnetsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrBlock string
+	var newbits int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidrBlock, &newbits))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := itypes.CIDRBlockSubnets(cidrBlock, int(newbits))
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
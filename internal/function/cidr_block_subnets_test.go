@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDRBlockSubnetsFunction_ipv4(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRBlockSubnetsFunctionConfig("10.0.0.0/16", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `["10.0.0.0/18","10.0.64.0/18","10.0.128.0/18","10.0.192.0/18"]`),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRBlockSubnetsFunction_ipv6(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRBlockSubnetsFunctionConfig("2001:db8::/56", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `["2001:db8::/57","2001:db8:0:80::/57"]`),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRBlockSubnetsFunction_invalidCIDRBlock(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRBlockSubnetsFunctionConfig("10.0.0.1/16", 2),
+				ExpectError: regexache.MustCompile(`not[\s\n]*a[\s\n]*valid[\s\n]*CIDR[\s\n]*block`),
+			},
+		},
+	})
+}
+
+func TestCIDRBlockSubnetsFunction_insufficientAddressSpace(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRBlockSubnetsFunctionConfig("10.0.0.0/30", 3),
+				ExpectError: regexache.MustCompile(`insufficient[\s\n]*address[\s\n]*space`),
+			},
+		},
+	})
+}
+
+func testCIDRBlockSubnetsFunctionConfig(cidrBlock string, newbits int) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = jsonencode(provider::aws::cidr_block_subnets(%[1]q, %[2]d))
+}`, cidrBlock, newbits)
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+)
+
+var _ function.Function = nameTruncateFunction{}
+
+func NewNameTruncateFunction() function.Function {
+	return &nameTruncateFunction{}
+}
+
+type nameTruncateFunction struct{}
+
+func (f nameTruncateFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "name_truncate"
+}
+
+func (f nameTruncateFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "name_truncate Function",
+		MarkdownDescription: "Truncates a resource name to a maximum number of characters. " +
+			"If the name ends with a Terraform generated unique suffix, the suffix is preserved and the prefix is truncated. " +
+			"Multi-byte characters are never split.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "Resource name to truncate",
+			},
+			function.Int64Parameter{
+				Name:                "max_length",
+				MarkdownDescription: "Maximum number of characters in the result",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f nameTruncateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+	var maxLength int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name, &maxLength))
+	if resp.Error != nil {
+		return
+	}
+
+	if maxLength < 0 {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, fmt.Sprintf("max_length (%d) must not be negative", maxLength)))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, create.TruncateName(name, int(maxLength))))
+}
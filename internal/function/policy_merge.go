@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var _ function.Function = policyMergeFunction{}
+
+func NewPolicyMergeFunction() function.Function {
+	return &policyMergeFunction{}
+}
+
+type policyMergeFunction struct{}
+
+func (f policyMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "policy_merge"
+}
+
+func (f policyMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "policy_merge Function",
+		MarkdownDescription: "Merges IAM policy documents into a single normalized policy document. " +
+			"Statements from later documents replace statements from earlier documents with the same `Sid`. " +
+			"Statements without a `Sid` are appended.",
+		VariadicParameter: function.StringParameter{
+			Name:                "policies",
+			MarkdownDescription: "IAM policy documents in JSON format",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f policyMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var args []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &args))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := mergePolicies(args)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// policyDocument is a minimal IAM policy document representation.
+// Statement contents are opaque so that no element is lost when merging.
+type policyDocument struct {
+	Version    string
+	Id         string
+	Statements []map[string]any
+}
+
+func (d *policyDocument) UnmarshalJSON(b []byte) error {
+	var raw struct {
+		Version   string
+		Id        string
+		Statement json.RawMessage
+	}
+
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	d.Version = raw.Version
+	d.Id = raw.Id
+	d.Statements = nil
+
+	if len(raw.Statement) == 0 {
+		return nil
+	}
+
+	// Statement may be a single object or an array of objects.
+	if err := json.Unmarshal(raw.Statement, &d.Statements); err != nil {
+		var statement map[string]any
+		if err := json.Unmarshal(raw.Statement, &statement); err != nil {
+			return fmt.Errorf("policy Statement must be an object or an array of objects: %w", err)
+		}
+		d.Statements = []map[string]any{statement}
+	}
+
+	return nil
+}
+
+func (d *policyDocument) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Version   string           `json:",omitempty"`
+		Id        string           `json:",omitempty"`
+		Statement []map[string]any `json:",omitempty"`
+	}{
+		Version:   d.Version,
+		Id:        d.Id,
+		Statement: d.Statements,
+	})
+}
+
+// merge merges newDoc into d, using the same semantics as the
+// aws_iam_policy_document data source's override_policy_documents argument.
+func (d *policyDocument) merge(newDoc *policyDocument) {
+	// adopt newDoc's Id
+	if newDoc.Id != "" {
+		d.Id = newDoc.Id
+	}
+
+	// let newDoc upgrade our Version
+	if newDoc.Version > d.Version {
+		d.Version = newDoc.Version
+	}
+
+	// merge in newDoc's statements, overwriting any existing Sids
+	for _, newStatement := range newDoc.Statements {
+		sid, _ := newStatement["Sid"].(string)
+		if sid == "" {
+			d.Statements = append(d.Statements, newStatement)
+			continue
+		}
+
+		seen := false
+		for i, existingStatement := range d.Statements {
+			if v, _ := existingStatement["Sid"].(string); v == sid {
+				d.Statements[i] = newStatement
+				seen = true
+				break
+			}
+		}
+		if !seen {
+			d.Statements = append(d.Statements, newStatement)
+		}
+	}
+}
+
+// mergePolicies merges the specified IAM policy documents in order and
+// returns the normalized result.
+// If the merged document is equivalent to the first document, the first
+// document is returned (normalized) to avoid spurious statement reordering.
+func mergePolicies(policies []string) (string, error) {
+	if len(policies) == 0 {
+		return "", nil
+	}
+
+	var merged policyDocument
+	for i, policy := range policies {
+		var doc policyDocument
+		if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+			return "", fmt.Errorf("parsing policy %d: %w", i+1, err)
+		}
+
+		merged.merge(&doc)
+	}
+
+	b, err := json.Marshal(&merged)
+	if err != nil {
+		return "", err
+	}
+
+	policy, err := verify.PolicyToSet(policies[0], string(b))
+	if err != nil {
+		return "", err
+	}
+
+	return verify.LegacyPolicyNormalize(policy)
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestPolicyMergeFunction_basic(t *testing.T) {
+	t.Parallel()
+	args := []string{
+		`{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		`{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:ListBucket","Resource":"*"}}`,
+	}
+	expected := `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"*","Sid":"Read"},{"Action":"s3:ListBucket","Effect":"Allow","Resource":"*"}]}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testPolicyMergeFunctionConfig(args...),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", expected),
+				),
+			},
+		},
+	})
+}
+
+func TestPolicyMergeFunction_overrideSid(t *testing.T) {
+	t.Parallel()
+	args := []string{
+		`{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		`{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}`,
+	}
+	expected := `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Deny","Resource":"*","Sid":"Read"}]}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testPolicyMergeFunctionConfig(args...),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", expected),
+				),
+			},
+		},
+	})
+}
+
+func TestPolicyMergeFunction_invalidPolicy(t *testing.T) {
+	t.Parallel()
+	args := []string{
+		`{"Version":"2012-10-17","Statement":[]}`,
+		`{"Version":"2012-10-17","Statement":"foo"}`,
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testPolicyMergeFunctionConfig(args...),
+				ExpectError: regexache.MustCompile(`parsing[\s\n]*policy[\s\n]*2`),
+			},
+		},
+	})
+}
+
+func testPolicyMergeFunctionConfig(args ...string) string {
+	quoted := make([]string, 0, len(args))
+	for _, arg := range args {
+		quoted = append(quoted, strconv.Quote(arg))
+	}
+
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::policy_merge(%[1]s)
+}`, strings.Join(quoted, ", "))
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var _ function.Function = policyNormalizeFunction{}
+
+func NewPolicyNormalizeFunction() function.Function {
+	return &policyNormalizeFunction{}
+}
+
+type policyNormalizeFunction struct{}
+
+func (f policyNormalizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "policy_normalize"
+}
+
+func (f policyNormalizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "policy_normalize Function",
+		MarkdownDescription: "Normalizes an IAM policy document. Insignificant whitespace is removed, " +
+			"object keys are sorted and the `Version` element is placed first as required by AWS.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "policy",
+				MarkdownDescription: "IAM policy document in JSON format",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f policyNormalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := normalizePolicy(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// normalizePolicy returns the normalized form of an IAM policy document,
+// with the Version element first.
+func normalizePolicy(policy string) (string, error) {
+	// Reuse the resource read path so that the result matches what would be
+	// stored in state for an unchanged policy.
+	policy, err := verify.PolicyToSet("", policy)
+	if err != nil {
+		return "", err
+	}
+
+	return verify.LegacyPolicyNormalize(policy)
+}
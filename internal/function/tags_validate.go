@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+var _ function.Function = tagsValidateFunction{}
+
+func NewTagsValidateFunction() function.Function {
+	return &tagsValidateFunction{}
+}
+
+type tagsValidateFunction struct{}
+
+func (f tagsValidateFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tags_validate"
+}
+
+func (f tagsValidateFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "tags_validate Function",
+		MarkdownDescription: "Validates a map of tags against the limits common to most AWS services " +
+			"and returns the map unchanged. An error is raised if any limit is exceeded.",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:                "tags",
+				MarkdownDescription: "Map of tags to validate",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f tagsValidateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg map[string]string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	if err := tftags.New(ctx, arg).Validate(); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, arg))
+}
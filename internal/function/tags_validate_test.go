@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestTagsValidateFunction_valid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testTagsValidateFunctionConfig(`{
+    Name        = "example"
+    Environment = "test:env/1"
+  }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Environment":"test:env/1","Name":"example"}`),
+				),
+			},
+		},
+	})
+}
+
+func TestTagsValidateFunction_reservedPrefix(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testTagsValidateFunctionConfig(`{
+    "aws:key" = "value"
+  }`),
+				ExpectError: regexache.MustCompile(`must[\s\n]*not[\s\n]*begin[\s\n]*with`),
+			},
+		},
+	})
+}
+
+func TestTagsValidateFunction_valueTooLong(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testTagsValidateFunctionConfig(`{
+    key = join("", [for i in range(257) : "v"])
+  }`),
+				ExpectError: regexache.MustCompile(`value[\s\n]*length[\s\n]*must[\s\n]*be[\s\n]*at[\s\n]*most`),
+			},
+		},
+	})
+}
+
+func testTagsValidateFunctionConfig(tags string) string {
+	return `
+output "test" {
+  value = jsonencode(provider::aws::tags_validate(` + tags + `))
+}`
+}
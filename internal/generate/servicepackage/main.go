@@ -53,13 +53,16 @@ func main() {
 			g.Fatalf("cannot generate AWS SDK for Go v1 client")
 		}
 
-		// Look for Terraform Plugin Framework and SDK resource and data source annotations.
+		// Look for Terraform Plugin Framework and SDK resource and data source annotations,
+		// Terraform Plugin Framework ephemeral resource annotations and list resource annotations.
 		// These annotations are implemented as comments on factory functions.
 		v := &visitor{
 			g: g,
 
+			ephemeralResources:   make([]ResourceDatum, 0),
 			frameworkDataSources: make([]ResourceDatum, 0),
 			frameworkResources:   make([]ResourceDatum, 0),
+			listResources:        make(map[string]ResourceDatum),
 			sdkDataSources:       make(map[string]ResourceDatum),
 			sdkResources:         make(map[string]ResourceDatum),
 		}
@@ -76,12 +79,17 @@ func main() {
 			GoV2Package:          l.GoV2Package(),
 			ProviderPackage:      p,
 			ProviderNameUpper:    l.ProviderNameUpper(),
+			EphemeralResources:   v.ephemeralResources,
 			FrameworkDataSources: v.frameworkDataSources,
 			FrameworkResources:   v.frameworkResources,
+			ListResources:        v.listResources,
 			SDKDataSources:       v.sdkDataSources,
 			SDKResources:         v.sdkResources,
 		}
 
+		slices.SortStableFunc(s.EphemeralResources, func(a, b ResourceDatum) int {
+			return cmp.Compare(a.FactoryName, b.FactoryName)
+		})
 		slices.SortStableFunc(s.FrameworkDataSources, func(a, b ResourceDatum) int {
 			return cmp.Compare(a.FactoryName, b.FactoryName)
 		})
@@ -131,8 +139,10 @@ type ServiceDatum struct {
 	GoV2Package          string // AWS SDK for Go v2 package name
 	ProviderPackage      string
 	ProviderNameUpper    string
+	EphemeralResources   []ResourceDatum
 	FrameworkDataSources []ResourceDatum
 	FrameworkResources   []ResourceDatum
+	ListResources        map[string]ResourceDatum
 	SDKDataSources       map[string]ResourceDatum
 	SDKResources         map[string]ResourceDatum
 }
@@ -156,8 +166,10 @@ type visitor struct {
 	functionName string
 	packageName  string
 
+	ephemeralResources   []ResourceDatum
 	frameworkDataSources []ResourceDatum
 	frameworkResources   []ResourceDatum
+	listResources        map[string]ResourceDatum
 	sdkDataSources       map[string]ResourceDatum
 	sdkResources         map[string]ResourceDatum
 }
@@ -197,7 +209,8 @@ func (v *visitor) processFile(file *ast.File) {
 }
 
 // processFuncDecl processes a single Go function.
-// The function's comments are scanned for annotations indicating a Plugin Framework or SDK resource or data source.
+// The function's comments are scanned for annotations indicating a Plugin Framework or SDK resource or data source,
+// a Plugin Framework ephemeral resource or a list resource.
 func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 	v.functionName = funcDecl.Name.Name
 
@@ -239,6 +252,14 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 			}
 
 			switch annotationName := m[1]; annotationName {
+			case "EphemeralResource":
+				if d.TransparentTagging {
+					v.errs = append(v.errs, fmt.Errorf("transparent tagging not supported for Ephemeral Resource: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else if slices.ContainsFunc(v.ephemeralResources, func(d ResourceDatum) bool { return d.FactoryName == v.functionName }) {
+					v.errs = append(v.errs, fmt.Errorf("duplicate Ephemeral Resource: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else {
+					v.ephemeralResources = append(v.ephemeralResources, d)
+				}
 			case "FrameworkDataSource":
 				if slices.ContainsFunc(v.frameworkDataSources, func(d ResourceDatum) bool { return d.FactoryName == v.functionName }) {
 					v.errs = append(v.errs, fmt.Errorf("duplicate Framework Data Source: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
@@ -251,6 +272,21 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.frameworkResources = append(v.frameworkResources, d)
 				}
+			case "ListResource":
+				if len(args.Positional) == 0 {
+					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				typeName := args.Positional[0]
+
+				if d.TransparentTagging {
+					v.errs = append(v.errs, fmt.Errorf("transparent tagging not supported for List Resource: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else if _, ok := v.listResources[typeName]; ok {
+					v.errs = append(v.errs, fmt.Errorf("duplicate List Resource (%s): %s", typeName, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else {
+					v.listResources[typeName] = d
+				}
 			case "SDKDataSource":
 				if len(args.Positional) == 0 {
 					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -68,6 +69,11 @@ type resourceInterceptor interface {
 	delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
 }
 
+// resourceModifyPlanInterceptor is implemented by resource interceptors that are invoked after the resource's ModifyPlan method.
+type resourceModifyPlanInterceptor interface {
+	modifyPlan(context.Context, resource.ModifyPlanRequest, *resource.ModifyPlanResponse, *conns.AWSClient, diag.Diagnostics) diag.Diagnostics
+}
+
 type resourceInterceptors []resourceInterceptor
 
 type resourceInterceptorFunc[Request resourceCRUDRequest, Response resourceCRUDResponse] interceptorFunc[Request, Response]
@@ -93,6 +99,19 @@ func (s resourceInterceptors) update() []resourceInterceptorFunc[resource.Update
 	})
 }
 
+// modifyPlan returns a slice of interceptors that run on resource ModifyPlan.
+func (s resourceInterceptors) modifyPlan() []resourceModifyPlanInterceptor {
+	var interceptors []resourceModifyPlanInterceptor
+
+	for _, v := range s {
+		if v, ok := v.(resourceModifyPlanInterceptor); ok {
+			interceptors = append(interceptors, v)
+		}
+	}
+
+	return interceptors
+}
+
 // delete returns a slice of interceptors that run on resource Delete.
 func (s resourceInterceptors) delete() []resourceInterceptorFunc[resource.DeleteRequest, resource.DeleteResponse] {
 	return slices.ApplyToAll(s, func(e resourceInterceptor) resourceInterceptorFunc[resource.DeleteRequest, resource.DeleteResponse] {
@@ -227,6 +246,7 @@ func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadReq
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = conns.NewOperationContext(ctx, "Read")
 	diags := interceptedDataSourceReadHandler(w.interceptors.read(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
@@ -305,6 +325,8 @@ func (r tagsDataSourceInterceptor) read(ctx context.Context, request datasource.
 					return ctx, diags
 				}
 
+				sp, _ := meta.TaggingServicePackage(ctx, sp, identifier)
+
 				// If the service package has a generic resource list tags methods, call it.
 				var err error
 				if v, ok := sp.(interface {
@@ -355,6 +377,77 @@ func (r tagsDataSourceInterceptor) read(ctx context.Context, request datasource.
 	return ctx, diags
 }
 
+// wrappedEphemeralResource represents an interceptor dispatcher for a Plugin Framework ephemeral resource.
+type wrappedEphemeralResource struct {
+	// bootstrapContext is run on all wrapped methods before any interceptors.
+	bootstrapContext contextFunc
+	inner            ephemeral.EphemeralResourceWithConfigure
+	meta             *conns.AWSClient
+}
+
+func newWrappedEphemeralResource(bootstrapContext contextFunc, inner ephemeral.EphemeralResourceWithConfigure) ephemeral.EphemeralResourceWithConfigure {
+	return &wrappedEphemeralResource{
+		bootstrapContext: bootstrapContext,
+		inner:            inner,
+	}
+}
+
+func (w *wrappedEphemeralResource) Metadata(ctx context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Metadata(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Schema(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = conns.NewOperationContext(ctx, "Open")
+	w.inner.Open(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Configure(ctx context.Context, request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Configure(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Renew(ctx context.Context, request ephemeral.RenewRequest, response *ephemeral.RenewResponse) {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithRenew); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		ctx = conns.NewOperationContext(ctx, "Renew")
+		v.Renew(ctx, request, response)
+	}
+}
+
+func (w *wrappedEphemeralResource) Close(ctx context.Context, request ephemeral.CloseRequest, response *ephemeral.CloseResponse) {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithClose); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		ctx = conns.NewOperationContext(ctx, "Close")
+		v.Close(ctx, request, response)
+	}
+}
+
+func (w *wrappedEphemeralResource) ConfigValidators(ctx context.Context) []ephemeral.ConfigValidator {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithConfigValidators); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		return v.ConfigValidators(ctx)
+	}
+
+	return nil
+}
+
+func (w *wrappedEphemeralResource) ValidateConfig(ctx context.Context, request ephemeral.ValidateConfigRequest, response *ephemeral.ValidateConfigResponse) {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithValidateConfig); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		v.ValidateConfig(ctx, request, response)
+	}
+}
+
 // wrappedResource represents an interceptor dispatcher for a Plugin Framework resource.
 type wrappedResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
@@ -388,6 +481,7 @@ func (w *wrappedResource) Create(ctx context.Context, request resource.CreateReq
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = conns.NewOperationContext(ctx, "Create")
 	diags := interceptedResourceHandler(w.interceptors.create(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
@@ -398,6 +492,7 @@ func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = conns.NewOperationContext(ctx, "Read")
 	diags := interceptedResourceHandler(w.interceptors.read(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
@@ -408,6 +503,7 @@ func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateReq
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = conns.NewOperationContext(ctx, "Update")
 	diags := interceptedResourceHandler(w.interceptors.update(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
@@ -418,6 +514,7 @@ func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteReq
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	ctx = conns.NewOperationContext(ctx, "Delete")
 	diags := interceptedResourceHandler(w.interceptors.delete(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
@@ -433,6 +530,11 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		if w.meta != nil {
+			ctx = conns.NewImportContext(ctx, w.meta.AccountID, w.meta.Region)
+		} else {
+			ctx = conns.NewOperationContext(ctx, "Import")
+		}
 		v.ImportState(ctx, request, response)
 
 		return
@@ -445,9 +547,27 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
-	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ModifyPlan(ctx, request, response)
+	inner, ok := w.inner.(resource.ResourceWithModifyPlan)
+	// Only resources registered with transparent tagging have ModifyPlan interceptors.
+	interceptors := w.interceptors.modifyPlan()
+
+	if !ok && len(interceptors) == 0 {
+		return
+	}
+
+	ctx = w.bootstrapContext(ctx, w.meta)
+
+	if ok {
+		inner.ModifyPlan(ctx, request, response)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Interceptors run whether or not the resource implements ModifyPlan.
+	for _, v := range interceptors {
+		response.Diagnostics = v.modifyPlan(ctx, request, response, w.meta, response.Diagnostics)
 	}
 }
 
@@ -523,6 +643,33 @@ func (r tagsResourceInterceptor) create(ctx context.Context, request resource.Cr
 
 		tagsInContext.TagsIn = option.Some(tags)
 	case After:
+		// Resources in service packages without tag methods could not be tagged on creation.
+		if identifierAttribute := r.tags.IdentifierAttribute; identifierAttribute != "" {
+			if sp, ok := meta.ServicePackages[inContext.ServicePackageName]; ok {
+				var identifier string
+				diags.Append(response.State.GetAttribute(ctx, path.Root(identifierAttribute), &identifier)...)
+
+				if diags.HasError() {
+					return ctx, diags
+				}
+
+				if sp, fallback := meta.TaggingServicePackage(ctx, sp, identifier); fallback {
+					if v, ok := sp.(tftags.ServiceTagUpdater); ok {
+						if err := v.UpdateTags(ctx, meta, identifier, nil, tagsInContext.TagsIn.UnwrapOrDefault()); err != nil {
+							serviceName, nameErr := names.HumanFriendly(inContext.ServicePackageName)
+							if nameErr != nil {
+								serviceName = "<service>"
+							}
+
+							diags.AddError(fmt.Sprintf("creating tags for %s %s (%s)", serviceName, inContext.ResourceName, identifier), err.Error())
+
+							return ctx, diags
+						}
+					}
+				}
+			}
+		}
+
 		// Set values for unknowns.
 		// Remove any provider configured ignore_tags and system tags from those passed to the service API.
 		// Computed tags_all include any provider configured default_tags.
@@ -537,6 +684,94 @@ func (r tagsResourceInterceptor) create(ctx context.Context, request resource.Cr
 	return ctx, diags
 }
 
+// modifyPlan refuses configured tags on a resource whose tags cannot be set and
+// evaluates a new resource's tags, or a resource's changed tags, against any provider configured tag policy.
+func (r tagsResourceInterceptor) modifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, meta *conns.AWSClient, diags diag.Diagnostics) diag.Diagnostics {
+	if r.tags == nil || meta == nil {
+		return diags
+	}
+
+	// If the entire plan is null, the resource is planned for destruction.
+	if request.Plan.Raw.IsNull() {
+		return diags
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return diags
+	}
+
+	// Tags configured on a resource whose service package has no methods to list or update resource tags
+	// and for which the Resource Groups Tagging API fallback is not configured would otherwise be silently ignored.
+	if r.tags.IdentifierAttribute != "" {
+		if sp, ok := meta.ServicePackages[inContext.ServicePackageName]; ok && !meta.SupportsTagging(ctx, sp) {
+			var planTags tftags.Map
+			diags.Append(response.Plan.GetAttribute(ctx, path.Root(names.AttrTags), &planTags)...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if len(planTags.Elements()) > 0 {
+				diags.AddAttributeError(path.Root(names.AttrTags), "Tagging Not Supported",
+					fmt.Sprintf("%s does not support tagging: remove %q or set the provider's resource_tagging_api_fallback argument", inContext.TypeName, names.AttrTags))
+
+				return diags
+			}
+		}
+	}
+
+	policy := meta.TagPolicyConfig(ctx)
+	if policy == nil {
+		return diags
+	}
+
+	var planTagsAll tftags.Map
+	diags.Append(response.Plan.GetAttribute(ctx, path.Root(names.AttrTagsAll), &planTagsAll)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if !request.State.Raw.IsNull() {
+		var stateTagsAll tftags.Map
+		diags.Append(request.State.GetAttribute(ctx, path.Root(names.AttrTagsAll), &stateTagsAll)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if planTagsAll.Equal(stateTagsAll) {
+			return diags
+		}
+	}
+
+	var planTags tftags.Map
+	diags.Append(response.Plan.GetAttribute(ctx, path.Root(names.AttrTags), &planTags)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if planTags.IsUnknown() {
+		return diags
+	}
+	for _, v := range planTags.Elements() {
+		if v.IsUnknown() {
+			return diags
+		}
+	}
+
+	tags := meta.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, planTags))
+	tags = tags.IgnoreSystem(inContext.ServicePackageName)
+
+	for _, v := range policy.Evaluate(inContext.TypeName, tags) {
+		if policy.Enforcement == tftags.PolicyEnforcementWarning {
+			diags.AddAttributeWarning(path.Root(names.AttrTags), "Tag Policy Violation", v)
+		} else {
+			diags.AddAttributeError(path.Root(names.AttrTags), "Tag Policy Violation", v)
+		}
+	}
+
+	return diags
+}
+
 func (r tagsResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	if r.tags == nil {
 		return ctx, diags
@@ -588,6 +823,8 @@ func (r tagsResourceInterceptor) read(ctx context.Context, request resource.Read
 				// Some old resources may not have the required attribute set after Read:
 				// https://github.com/hashicorp/terraform-provider-aws/issues/31180
 				if identifier != "" {
+					sp, _ := meta.TaggingServicePackage(ctx, sp, identifier)
+
 					// If the service package has a generic resource list tags methods, call it.
 					var err error
 
@@ -627,6 +864,15 @@ func (r tagsResourceInterceptor) read(ctx context.Context, request resource.Read
 		// AWS APIs often return empty lists of tags when none have been configured.
 		var stateTags tftags.Map
 		response.State.GetAttribute(ctx, path.Root(names.AttrTags), &stateTags)
+
+		if err := meta.SetCloudFormationStackTagsPropagated(ctx, apiTags); err != nil {
+			diags.AddError(fmt.Sprintf("reading tags propagated to %s %s", serviceName, resourceName), err.Error())
+
+			return ctx, diags
+		}
+
+		// Remove any tags propagated from a parent resource that are not configured.
+		apiTags = tagsInContext.RemoveIgnoredPropagated(apiTags, tftags.New(ctx, stateTags))
 		// Remove any provider configured ignore_tags and system tags from those returned from the service API.
 		// The resource's configured tags do not include any provider configured default_tags.
 		if v := apiTags.IgnoreSystem(inContext.ServicePackageName).IgnoreConfig(tagsInContext.IgnoreConfig).ResolveDuplicatesFramework(ctx, tagsInContext.DefaultConfig, tagsInContext.IgnoreConfig, response, &diags).Map(); len(v) > 0 {
@@ -723,6 +969,8 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 				// Some old resources may not have the required attribute set after Read:
 				// https://github.com/hashicorp/terraform-provider-aws/issues/31180
 				if identifier != "" {
+					sp, _ := meta.TaggingServicePackage(ctx, sp, identifier)
+
 					// If the service package has a generic resource update tags methods, call it.
 					var err error
 
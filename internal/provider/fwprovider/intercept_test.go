@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// testResource is a resource which does not implement ModifyPlan.
+type testResource struct{}
+
+func (testResource) Metadata(context.Context, resource.MetadataRequest, *resource.MetadataResponse) {
+}
+
+func (testResource) Schema(context.Context, resource.SchemaRequest, *resource.SchemaResponse) {
+}
+
+func (testResource) Configure(context.Context, resource.ConfigureRequest, *resource.ConfigureResponse) {
+}
+
+func (testResource) Create(context.Context, resource.CreateRequest, *resource.CreateResponse) {
+}
+
+func (testResource) Read(context.Context, resource.ReadRequest, *resource.ReadResponse) {
+}
+
+func (testResource) Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse) {
+}
+
+func (testResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
+
+type testModifyPlanInterceptor struct {
+	resourceInterceptor
+	called *bool
+}
+
+func (r testModifyPlanInterceptor) modifyPlan(_ context.Context, _ resource.ModifyPlanRequest, _ *resource.ModifyPlanResponse, _ *conns.AWSClient, diags diag.Diagnostics) diag.Diagnostics {
+	*r.called = true
+
+	return diags
+}
+
+func TestWrappedResourceModifyPlan(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		tagged       bool
+		expectCalled bool
+	}{
+		"untagged": {},
+		"tagged": {
+			tagged:       true,
+			expectCalled: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var bootstrapped, intercepted bool
+			bootstrapContext := func(ctx context.Context, _ *conns.AWSClient) context.Context {
+				bootstrapped = true
+				return ctx
+			}
+
+			interceptors := resourceInterceptors{}
+			if testCase.tagged {
+				interceptors = append(interceptors, testModifyPlanInterceptor{called: &intercepted})
+			}
+
+			r := newWrappedResource(bootstrapContext, testResource{}, interceptors)
+			r.(resource.ResourceWithModifyPlan).ModifyPlan(ctx, resource.ModifyPlanRequest{}, &resource.ModifyPlanResponse{})
+
+			if got, want := bootstrapped, testCase.expectCalled; got != want {
+				t.Errorf("bootstrapContext called %t, want %t", got, want)
+			}
+			if got, want := intercepted, testCase.expectCalled; got != want {
+				t.Errorf("ModifyPlan interceptor called %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+// testServicePackage is a service package without tag methods.
+type testServicePackage struct{}
+
+func (testServicePackage) FrameworkDataSources(context.Context) []*types.ServicePackageFrameworkDataSource {
+	return nil
+}
+
+func (testServicePackage) FrameworkResources(context.Context) []*types.ServicePackageFrameworkResource {
+	return nil
+}
+
+func (testServicePackage) SDKDataSources(context.Context) []*types.ServicePackageSDKDataSource {
+	return nil
+}
+
+func (testServicePackage) SDKResources(context.Context) []*types.ServicePackageSDKResource {
+	return nil
+}
+
+func (testServicePackage) ServicePackageName() string {
+	return "test"
+}
+
+func TestTagsResourceInterceptorModifyPlanTaggingNotSupported(t *testing.T) {
+	t.Parallel()
+
+	ctx := conns.NewResourceContext(context.Background(), "test", "Thing", "aws_test_thing")
+	meta := &conns.AWSClient{
+		ServicePackages: map[string]conns.ServicePackage{
+			"test": testServicePackage{},
+		},
+	}
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrTags: tftags.TagsAttribute(),
+		},
+	}
+	objectType := s.Type().TerraformType(ctx)
+	tagsType := tftypes.Map{ElementType: tftypes.String}
+
+	testCases := map[string]struct {
+		tags        tftypes.Value
+		expectError bool
+	}{
+		"no tags": {
+			tags: tftypes.NewValue(tagsType, nil),
+		},
+		"tags": {
+			tags: tftypes.NewValue(tagsType, map[string]tftypes.Value{
+				"key1": tftypes.NewValue(tftypes.String, "value1"),
+			}),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			plan := tfsdk.Plan{
+				Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+					names.AttrTags: testCase.tags,
+				}),
+				Schema: s,
+			}
+			request := resource.ModifyPlanRequest{Plan: plan}
+			response := resource.ModifyPlanResponse{Plan: plan}
+			interceptor := tagsResourceInterceptor{tags: &types.ServicePackageResourceTags{IdentifierAttribute: names.AttrARN}}
+
+			diags := interceptor.modifyPlan(ctx, request, &response, meta, nil)
+
+			if got, want := diags.HasError(), testCase.expectError; got != want {
+				t.Errorf("modifyPlan() error %t, want %t: %v", got, want, diags)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -22,11 +23,13 @@ import (
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 var _ provider.Provider = &fwprovider{}
 var _ provider.ProviderWithFunctions = &fwprovider{}
+var _ provider.ProviderWithEphemeralResources = &fwprovider{}
 
 // New returns a new, initialized Terraform Plugin Framework-style provider instance.
 // The provider instance is fully configured once the `Configure` method has been called.
@@ -101,6 +104,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "The region where AWS operations will take place. Examples\nare us-east-1, us-west-2, etc.", // lintignore:AWSAT003
 			},
+			"resource_tagging_api_fallback": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Use the Resource Groups Tagging API to list and update the tags of resources with an ARN whose service has no tagging API supported by the provider.",
+			},
 			"retry_mode": schema.StringAttribute{
 				Optional:    true,
 				Description: "Specifies how retries are attempted. Valid values are `standard` and `adaptive`. Can also be configured using the `AWS_RETRY_MODE` environment variable.",
@@ -264,6 +271,40 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 								"Can also be configured with environment variables like `" + tftags.DefaultTagsEnvVarPrefix + "<tag_name>`.",
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"rule": schema.ListNestedBlock{
+							Description: "Configuration blocks with resource tags to default across the resources of specific services or resource types.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"exclude_resource_types": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource types, which may contain `*` wildcards, that the rule does not apply to.",
+									},
+									"exclude_services": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Services, using the same identifiers as in the `endpoints` configuration block, that the rule does not apply to.",
+									},
+									"resource_types": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource types, which may contain `*` wildcards, that the rule applies to. The rule applies to all resource types if not set.",
+									},
+									"services": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Services, using the same identifiers as in the `endpoints` configuration block, that the rule applies to. The rule applies to all services if not set.",
+									},
+									"tags": schema.MapAttribute{
+										ElementType: types.StringType,
+										Required:    true,
+										Description: "Resource tags to default across the resources that the rule applies to.",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 			"endpoints": endpointsBlock(),
@@ -280,12 +321,140 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 							Description: "Resource tag key prefixes to ignore across all resources. " +
 								"Can also be configured with the " + tftags.IgnoreTagsKeyPrefixesEnvVar + " environment variable.",
 						},
+						"key_patterns": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Regular expressions matching resource tag keys to ignore across all resources.",
+						},
 						"keys": schema.SetAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
 							Description: "Resource tag keys to ignore across all resources. " +
 								"Can also be configured with the " + tftags.IgnoreTagsKeysEnvVar + " environment variable.",
 						},
+						"propagated": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Ignore resource tags that AWS propagates to a resource from a parent resource, unless they are configured on the resource or in `default_tags`.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"tag": schema.ListNestedBlock{
+							Description: "Configuration blocks matching resource tags to ignore across all resources by key and value.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"key_pattern": schema.StringAttribute{
+										Required:    true,
+										Description: "Regular expression matching the resource tag key.",
+									},
+									"value_pattern": schema.StringAttribute{
+										Optional:    true,
+										Description: "Regular expression matching the resource tag value. Any value matches if not set.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"local_emulator": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings for use with a local AWS emulator such as LocalStack or moto.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"account_id": schema.StringAttribute{
+							Optional: true,
+							Description: "AWS account ID reported by the emulator. " +
+								"Defaults to `" + conns.DefaultLocalEmulatorAccountID + "`.",
+						},
+						names.AttrEndpoint: schema.StringAttribute{
+							Optional: true,
+							Description: "Base URL of the emulator, used for all services without an endpoint configured in the `endpoints` block. " +
+								"Can also be configured using the `" + conns.LocalEmulatorEndpointEnvVar + "` environment variable.",
+						},
+					},
+				},
+			},
+			"retry_policy": schema.ListNestedBlock{
+				Description: "Configuration blocks with retry settings for individual services, overriding the provider-wide retry settings.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_attempts": schema.Int64Attribute{
+							Optional:    true,
+							Description: "Maximum number of attempts, including the initial attempt, for an API call to the service.",
+						},
+						"max_backoff": schema.StringAttribute{
+							Optional:    true,
+							Description: "Maximum delay between API call attempts to the service. Valid time units are ns, us (or µs), ms, s, h, or m.",
+						},
+						"retry_mode": schema.StringAttribute{
+							Optional:    true,
+							Description: "Specifies how retries are attempted for the service. Valid values are `standard` and `adaptive`.",
+						},
+						"retryable_error_codes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Additional API error codes which are retried for the service.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service, using the same identifier as in the `endpoints` configuration block.",
+						},
+					},
+				},
+			},
+			"tag_policy": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with the tag policy that resource tags are evaluated against when planning.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"enforcement": schema.StringAttribute{
+							Optional:    true,
+							Description: "How tag policy violations are reported. Valid values are `error` and `warning`. Defaults to `error`.",
+						},
+						"exempt_resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource types, e.g. `aws_instance`, that the tag policy is not applied to.",
+						},
+						"policy_file": schema.StringAttribute{
+							Optional:    true,
+							Description: "Path to an AWS Organizations tag policy JSON document whose tag key and tag value settings are added to the rules.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						names.AttrRule: schema.ListNestedBlock{
+							Description: "Rules for individual tag keys.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"allowed_values": schema.ListAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Regular expressions that the tag value must match one of.",
+									},
+									"exempt_resource_types": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource types, e.g. `aws_instance`, that the rule is not applied to.",
+									},
+									"ignore_case": schema.BoolAttribute{
+										Optional:    true,
+										Description: "Whether the tag key and value are compared case-insensitively.",
+									},
+									names.AttrKey: schema.StringAttribute{
+										Required:    true,
+										Description: "The tag key.",
+									},
+									"required": schema.BoolAttribute{
+										Optional:    true,
+										Description: "Whether the tag must be present.",
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -301,6 +470,7 @@ func (p *fwprovider) Configure(ctx context.Context, request provider.ConfigureRe
 	v := p.Primary.Meta()
 	response.DataSourceData = v
 	response.ResourceData = v
+	response.EphemeralResourceData = v
 }
 
 // DataSources returns a slice of functions to instantiate each DataSource
@@ -333,7 +503,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName)
 				if meta != nil {
 					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig(ctx), meta.IgnoreTagsConfig(ctx))
 					ctx = meta.RegisterLogger(ctx)
@@ -369,6 +539,26 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 		}
 	}
 
+	listResources, err := p.listResources(ctx)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	// The resource list data source is implemented by the provider itself.
+	dataSources = append(dataSources, func() datasource.DataSource {
+		bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
+			ctx = conns.NewDataSourceContext(ctx, "", "Resource List", "aws_resource_list")
+			if meta != nil {
+				ctx = meta.RegisterLogger(ctx)
+				ctx = flex.RegisterLogger(ctx)
+			}
+
+			return ctx
+		}
+
+		return newWrappedDataSource(bootstrapContext, newResourceListDataSource(listResources), dataSourceInterceptors{})
+	})
+
 	if err := errors.Join(errs...); err != nil {
 		tflog.Warn(ctx, "registering data sources", map[string]interface{}{
 			"error": err.Error(),
@@ -404,7 +594,7 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 				if meta != nil {
 					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig(ctx), meta.IgnoreTagsConfig(ctx))
 					ctx = meta.RegisterLogger(ctx)
@@ -458,6 +648,95 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	return resources
 }
 
+// EphemeralResources returns a slice of functions to instantiate each EphemeralResource
+// implementation.
+//
+// The ephemeral resource type name is determined by the EphemeralResource implementing
+// the Metadata method. All ephemeral resources must have unique names.
+func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	var errs []error
+	var ephemeralResources []func() ephemeral.EphemeralResource
+
+	for _, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages {
+		sp, ok := sp.(conns.ServicePackageWithEphemeralResources)
+		if !ok {
+			continue
+		}
+		servicePackageName := sp.ServicePackageName()
+
+		for _, v := range sp.EphemeralResources(ctx) {
+			inner, err := v.Factory(ctx)
+
+			if err != nil {
+				errs = append(errs, fmt.Errorf("creating ephemeral resource: %w", err))
+				continue
+			}
+
+			metadataResponse := ephemeral.MetadataResponse{}
+			inner.Metadata(ctx, ephemeral.MetadataRequest{}, &metadataResponse)
+			typeName := metadataResponse.TypeName
+
+			// bootstrapContext is run on all wrapped methods before any interceptors.
+			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
+				ctx = conns.NewEphemeralResourceContext(ctx, servicePackageName, v.Name, typeName)
+				if meta != nil {
+					ctx = meta.RegisterLogger(ctx)
+					ctx = flex.RegisterLogger(ctx)
+				}
+
+				return ctx
+			}
+
+			ephemeralResources = append(ephemeralResources, func() ephemeral.EphemeralResource {
+				return newWrappedEphemeralResource(bootstrapContext, inner)
+			})
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		tflog.Warn(ctx, "registering ephemeral resources", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	return ephemeralResources
+}
+
+// listResources returns the registered list resources, keyed by resource type name.
+func (p *fwprovider) listResources(ctx context.Context) (map[string]listResource, error) {
+	var errs []error
+	listResources := make(map[string]listResource)
+
+	for _, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages {
+		sp, ok := sp.(conns.ServicePackageWithListResources)
+		if !ok {
+			continue
+		}
+		servicePackageName := sp.ServicePackageName()
+
+		for _, v := range sp.ListResources(ctx) {
+			typeName := v.TypeName
+
+			if _, ok := listResources[typeName]; ok {
+				errs = append(errs, fmt.Errorf("duplicate list resource: %s", typeName))
+				continue
+			}
+
+			list := v.List
+			listResources[typeName] = listResource{
+				list: func(ctx context.Context, meta any, request inttypes.ListResourceRequest, fn func(inttypes.ListResult) bool) error {
+					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
+
+					return list(ctx, meta, request, fn)
+				},
+				name: v.Name,
+			}
+		}
+	}
+
+	return listResources, errors.Join(errs...)
+}
+
 // Functions returns a slice of functions to instantiate each Function
 // implementation.
 //
@@ -467,6 +746,11 @@ func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewCIDRBlockSubnetsFunction,
+		tffunction.NewNameTruncateFunction,
+		tffunction.NewPolicyMergeFunction,
+		tffunction.NewPolicyNormalizeFunction,
+		tffunction.NewTagsValidateFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 	}
 }
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// listResource is a registered list resource.
+type listResource struct {
+	list inttypes.ListResourceFunc
+	name string
+}
+
+// newResourceListDataSource returns the aws_resource_list data source, which lists
+// the instances of any resource type for which a list resource is registered.
+func newResourceListDataSource(listResources map[string]listResource) datasource.DataSourceWithConfigure {
+	return &resourceListDataSource{
+		listResources: listResources,
+	}
+}
+
+type resourceListDataSource struct {
+	framework.DataSourceWithConfigure
+
+	listResources map[string]listResource
+}
+
+func (*resourceListDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_resource_list"
+}
+
+func (d *resourceListDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	typeNames := tfmaps.Keys(d.listResources)
+	slices.Sort(typeNames)
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				Optional: true,
+			},
+			"resource_type": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(typeNames...),
+				},
+			},
+			"results": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[resourceListResultModel](ctx),
+				Computed:   true,
+			},
+			names.AttrTags: schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (d *resourceListDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data resourceListDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	typeName := data.ResourceType.ValueString()
+	v, ok := d.listResources[typeName]
+	if !ok {
+		response.Diagnostics.AddError("listing resources", fmt.Sprintf("resource type (%s) does not support listing", typeName))
+
+		return
+	}
+
+	namePrefix := data.NamePrefix.ValueString()
+	tags := fwflex.ExpandFrameworkStringValueMap(ctx, data.Tags)
+	input := inttypes.ListResourceRequest{
+		IncludeTags: len(tags) > 0,
+	}
+	results := make([]resourceListResultModel, 0)
+
+	err := v.list(ctx, d.Meta(), input, func(result inttypes.ListResult) bool {
+		if matchListResult(result, namePrefix, tags) {
+			results = append(results, resourceListResultModel{
+				DisplayName: fwflex.StringValueToFramework(ctx, result.DisplayName),
+				ImportID:    fwflex.StringValueToFramework(ctx, result.ImportID),
+				Tags:        fwflex.FlattenFrameworkStringValueMapLegacy(ctx, result.Tags),
+			})
+		}
+
+		return true
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("listing %s", v.name), err.Error())
+
+		return
+	}
+
+	data.Results = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, results)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// matchListResult returns whether a list result matches the specified name prefix and tags.
+// All specified tags must be present with equal values.
+func matchListResult(result inttypes.ListResult, namePrefix string, tags map[string]string) bool {
+	if !strings.HasPrefix(result.DisplayName, namePrefix) {
+		return false
+	}
+
+	for k, v := range tags {
+		if tv, ok := result.Tags[k]; !ok || tv != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+type resourceListDataSourceModel struct {
+	NamePrefix   types.String                                             `tfsdk:"name_prefix"`
+	ResourceType types.String                                             `tfsdk:"resource_type"`
+	Results      fwtypes.ListNestedObjectValueOf[resourceListResultModel] `tfsdk:"results"`
+	Tags         fwtypes.MapOfString                                      `tfsdk:"tags"`
+}
+
+type resourceListResultModel struct {
+	DisplayName types.String `tfsdk:"display_name"`
+	ImportID    types.String `tfsdk:"import_id"`
+	Tags        types.Map    `tfsdk:"tags"`
+}
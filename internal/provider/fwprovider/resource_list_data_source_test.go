@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"testing"
+
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+func TestMatchListResult(t *testing.T) {
+	t.Parallel()
+
+	result := inttypes.ListResult{
+		DisplayName: "app-role",
+		ImportID:    "app-role",
+		Tags: map[string]string{
+			"Team": "platform",
+			"Env":  "dev",
+		},
+	}
+
+	testCases := map[string]struct {
+		namePrefix string
+		tags       map[string]string
+		expected   bool
+	}{
+		"no filters": {
+			expected: true,
+		},
+		"matching name prefix": {
+			namePrefix: "app-",
+			expected:   true,
+		},
+		"non-matching name prefix": {
+			namePrefix: "web-",
+			expected:   false,
+		},
+		"matching tags": {
+			tags:     map[string]string{"Team": "platform"},
+			expected: true,
+		},
+		"non-matching tag value": {
+			tags:     map[string]string{"Team": "data"},
+			expected: false,
+		},
+		"missing tag": {
+			tags:     map[string]string{"Owner": "platform"},
+			expected: false,
+		},
+		"matching name prefix and tags": {
+			namePrefix: "app",
+			tags:       map[string]string{"Team": "platform", "Env": "dev"},
+			expected:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := matchListResult(result, testCase.namePrefix, testCase.tags), testCase.expected; got != want {
+				t.Errorf("matchListResult() = %t, want %t", got, want)
+			}
+		})
+	}
+}
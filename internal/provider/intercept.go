@@ -79,6 +79,21 @@ const (
 	AllOps = Create | Read | Update | Delete // Interceptor is invoked for all calls
 )
 
+func (w why) String() string {
+	switch w {
+	case Create:
+		return "Create"
+	case Read:
+		return "Read"
+	case Update:
+		return "Update"
+	case Delete:
+		return "Delete"
+	default:
+		return ""
+	}
+}
+
 type interceptorItems []interceptorItem
 
 // why returns a slice of interceptors that run for the specified CRUD operation.
@@ -93,6 +108,7 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 		var diags diag.Diagnostics
 		ctx = bootstrapContext(ctx, meta)
+		ctx = conns.NewOperationContext(ctx, why.String())
 		// Before interceptors are run first to last.
 		forward := interceptors.why(why)
 
@@ -174,6 +190,7 @@ func (r *wrappedResource) Delete(f schema.DeleteContextFunc) schema.DeleteContex
 func (r *wrappedResource) State(f schema.StateContextFunc) schema.StateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 		ctx = r.bootstrapContext(ctx, meta)
+		ctx = conns.NewOperationContext(ctx, "Import")
 
 		return f(ctx, d, meta)
 	}
@@ -245,6 +262,15 @@ func (r tagsResourceInterceptor) run(ctx context.Context, d schemaResourceData,
 
 			tagsInContext.TagsIn = option.Some(tags)
 
+			// Violations of a tag policy enforced with errors have already been reported when planning.
+			if policy := meta.(*conns.AWSClient).TagPolicyConfig(ctx); policy != nil && policy.Enforcement == tftags.PolicyEnforcementWarning {
+				if why == Create || d.HasChange(names.AttrTagsAll) {
+					for _, v := range policy.Evaluate(inContext.TypeName, tags) {
+						diags = sdkdiag.AppendWarningf(diags, "%s %s: tag policy violation: %s", serviceName, resourceName, v)
+					}
+				}
+			}
+
 			if why == Create {
 				break
 			}
@@ -264,6 +290,8 @@ func (r tagsResourceInterceptor) run(ctx context.Context, d schemaResourceData,
 						if identifier != "" {
 							o, n := d.GetChange(names.AttrTagsAll)
 
+							sp, _ := meta.(*conns.AWSClient).TaggingServicePackage(ctx, sp, identifier)
+
 							// If the service package has a generic resource update tags methods, call it.
 							var err error
 
@@ -317,6 +345,17 @@ func (r tagsResourceInterceptor) run(ctx context.Context, d schemaResourceData,
 					// Some old resources may not have the required attribute set after Read:
 					// https://github.com/hashicorp/terraform-provider-aws/issues/31180
 					if identifier != "" {
+						sp, fallback := meta.(*conns.AWSClient).TaggingServicePackage(ctx, sp, identifier)
+
+						// The resource could not be tagged on creation.
+						if fallback && why == Create {
+							if v, ok := sp.(tftags.ServiceTagUpdater); ok {
+								if err := v.UpdateTags(ctx, meta, identifier, nil, tagsInContext.TagsIn.UnwrapOrDefault()); err != nil {
+									return ctx, sdkdiag.AppendErrorf(diags, "creating tags for %s %s (%s): %s", serviceName, resourceName, identifier, err)
+								}
+							}
+						}
+
 						// If the service package has a generic resource list tags methods, call it.
 						var err error
 
@@ -356,8 +395,14 @@ func (r tagsResourceInterceptor) run(ctx context.Context, d schemaResourceData,
 				}
 			}
 
+			if err := meta.(*conns.AWSClient).SetCloudFormationStackTagsPropagated(ctx, tagsInContext.TagsOut.UnwrapOrDefault()); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "reading tags propagated to %s %s: %s", serviceName, resourceName, err)
+			}
+
 			// Remove any provider configured ignore_tags and system tags from those returned from the service API.
 			tags := tagsInContext.TagsOut.UnwrapOrDefault().IgnoreSystem(inContext.ServicePackageName).IgnoreConfig(tagsInContext.IgnoreConfig)
+			// Remove any tags propagated from a parent resource that are not configured.
+			tags = tagsInContext.RemoveIgnoredPropagated(tags, tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{})))
 
 			// The resource's configured tags can now include duplicate tags that have been configured on the provider.
 			if err := d.Set(names.AttrTags, tags.ResolveDuplicates(ctx, tagsInContext.DefaultConfig, tagsInContext.IgnoreConfig, d, names.AttrTags, nil).Map()); err != nil {
@@ -448,6 +493,8 @@ func (r tagsDataSourceInterceptor) run(ctx context.Context, d schemaResourceData
 					// Some old resources may not have the required attribute set after Read:
 					// https://github.com/hashicorp/terraform-provider-aws/issues/31180
 					if identifier != "" {
+						sp, _ := meta.(*conns.AWSClient).TaggingServicePackage(ctx, sp, identifier)
+
 						// If the service package has a generic resource list tags methods, call it.
 						var err error
 
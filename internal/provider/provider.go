@@ -8,7 +8,9 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/url"
 	"os"
+	"regexp"
 	"strings"
 	"time"
 
@@ -19,9 +21,11 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -68,6 +72,45 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"rule": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Configuration blocks with resource tags to default across the resources of specific services or resource types.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"exclude_resource_types": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource types, which may contain `*` wildcards, that the rule does not apply to.",
+									},
+									"exclude_services": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Services, using the same identifiers as in the `endpoints` configuration block, that the rule does not apply to.",
+									},
+									"resource_types": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource types, which may contain `*` wildcards, that the rule applies to. The rule applies to all resource types if not set.",
+									},
+									"services": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Services, using the same identifiers as in the `endpoints` configuration block, that the rule applies to. The rule applies to all services if not set.",
+									},
+									"tags": {
+										Type:        schema.TypeMap,
+										Required:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource tags to default across the resources that the rule applies to.",
+									},
+								},
+							},
+						},
 						"tags": {
 							Type:     schema.TypeMap,
 							Optional: true,
@@ -130,6 +173,39 @@ func New(ctx context.Context) (*schema.Provider, error) {
 							Description: "Resource tag key prefixes to ignore across all resources. " +
 								"Can also be configured with the " + tftags.IgnoreTagsKeyPrefixesEnvVar + " environment variable.",
 						},
+						"propagated": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Description: "Ignore resource tags that AWS propagates to a resource from a parent resource, " +
+								"unless they are configured on the resource or in `default_tags`.",
+						},
+						"key_patterns": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsValidRegExp},
+							Description: "Regular expressions matching resource tag keys to ignore across all resources.",
+						},
+						"tag": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Configuration blocks matching resource tags to ignore across all resources by key and value.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key_pattern": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsValidRegExp,
+										Description:  "Regular expression matching the resource tag key.",
+									},
+									"value_pattern": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsValidRegExp,
+										Description:  "Regular expression matching the resource tag value. Any value matches if not set.",
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -139,6 +215,28 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, " +
 					"default value is `false`",
 			},
+			"local_emulator": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings for use with a local AWS emulator such as LocalStack or moto.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: "AWS account ID reported by the emulator. " +
+								"Defaults to `" + conns.DefaultLocalEmulatorAccountID + "`.",
+						},
+						names.AttrEndpoint: {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: "Base URL of the emulator, used for all services without an endpoint configured in the `endpoints` block. " +
+								"Can also be configured using the `" + conns.LocalEmulatorEndpointEnvVar + "` environment variable.",
+						},
+					},
+				},
+			},
 			"max_retries": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -164,12 +262,56 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS operations will take place. Examples\n" +
 					"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 			},
+			"resource_tagging_api_fallback": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Use the Resource Groups Tagging API to list and update the tags of resources with an ARN " +
+					"whose service has no tagging API supported by the provider.",
+			},
 			"retry_mode": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Description: "Specifies how retries are attempted. Valid values are `standard` and `adaptive`. " +
 					"Can also be configured using the `AWS_RETRY_MODE` environment variable.",
 			},
+			"retry_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration blocks with retry settings for individual services, overriding the provider-wide retry settings.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Maximum number of attempts, including the initial attempt, for an API call to the service.",
+						},
+						"max_backoff": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validRetryPolicyMaxBackoff,
+							Description:  "Maximum delay between API call attempts to the service. Valid time units are ns, us (or µs), ms, s, h, or m.",
+						},
+						"retry_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(enum.Slice(aws.RetryModeStandard, aws.RetryModeAdaptive), false),
+							Description:  "Specifies how retries are attempted for the service. Valid values are `standard` and `adaptive`.",
+						},
+						"retryable_error_codes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Additional API error codes which are retried for the service.",
+						},
+						"service": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The service, using the same identifier as in the `endpoints` configuration block.",
+						},
+					},
+				},
+			},
 			"s3_use_path_style": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -234,6 +376,69 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS STS operations will take place. Examples\n" +
 					"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 			},
+			"tag_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with the tag policy that resource tags are evaluated against when planning.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enforcement": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[tftags.PolicyEnforcement](),
+							Description:      "How tag policy violations are reported. Valid values are `error` and `warning`. Defaults to `error`.",
+						},
+						"exempt_resource_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource types, e.g. `aws_instance`, that the tag policy is not applied to.",
+						},
+						"policy_file": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Path to an AWS Organizations tag policy JSON document whose tag key and tag value settings are added to the rules.",
+						},
+						names.AttrRule: {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allowed_values": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsValidRegExp},
+										Description: "Regular expressions that the tag value must match one of.",
+									},
+									"exempt_resource_types": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource types, e.g. `aws_instance`, that the rule is not applied to.",
+									},
+									"ignore_case": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Description: "Whether the tag key and value are compared case-insensitively.",
+									},
+									names.AttrKey: {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The tag key.",
+									},
+									"required": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Description: "Whether the tag must be present.",
+									},
+								},
+							},
+							Description: "Rules for individual tag keys.",
+						},
+					},
+				},
+			},
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -293,7 +498,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
 					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
 					ctx = v.RegisterLogger(ctx)
@@ -369,7 +574,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
 					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
 					ctx = v.RegisterLogger(ctx)
@@ -436,6 +641,15 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					r.Importer.StateContext = rs.State(v)
 				}
 			}
+			if v.Tags != nil {
+				// Check that configured tags can be set and evaluate any provider configured tag policy when planning.
+				tagsCustomizeDiff := customdiff.Sequence(tagsSupportedCustomizeDiff(v.Tags), tagPolicyCustomizeDiff)
+				if v := r.CustomizeDiff; v != nil {
+					r.CustomizeDiff = customdiff.Sequence(v, tagsCustomizeDiff)
+				} else {
+					r.CustomizeDiff = tagsCustomizeDiff
+				}
+			}
 			if v := r.CustomizeDiff; v != nil {
 				r.CustomizeDiff = rs.CustomizeDiff(v)
 			}
@@ -489,6 +703,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		MaxRetries:                     25, // Set default here, not in schema (muxing with v6 provider).
 		Profile:                        d.Get("profile").(string),
 		Region:                         d.Get("region").(string),
+		ResourceTaggingAPIFallback:     d.Get("resource_tagging_api_fallback").(bool),
 		S3UsePathStyle:                 d.Get("s3_use_path_style").(bool),
 		SecretKey:                      d.Get("secret_key").(string),
 		SkipCredsValidation:            d.Get("skip_credentials_validation").(bool),
@@ -510,6 +725,15 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.RetryMode = mode
 	}
 
+	if v, ok := d.GetOk("retry_policy"); ok && len(v.([]any)) > 0 {
+		policies, dx := expandRetryPolicies(ctx, v.([]any))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.RetryPolicies = policies
+	}
+
 	if v, ok := d.Get("s3_us_east_1_regional_endpoint").(string); ok && v != "" {
 		config.S3USEast1RegionalEndpoint = conns.NormalizeS3USEast1RegionalEndpoint(v)
 	}
@@ -560,7 +784,21 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	}
 
 	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		config.DefaultTagsConfig = expandDefaultTags(ctx, tfMap)
+
+		if v, ok := tfMap["rule"].([]interface{}); ok && len(v) > 0 {
+			rules, dx := expandDefaultTagsRules(ctx, v)
+			diags = append(diags, dx...)
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			if config.DefaultTagsConfig == nil {
+				config.DefaultTagsConfig = &tftags.DefaultConfig{}
+			}
+			config.DefaultTagsConfig.Rules = rules
+		}
 	} else {
 		config.DefaultTagsConfig = expandDefaultTags(ctx, nil)
 	}
@@ -598,6 +836,25 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, nil)
 	}
 
+	if v, ok := d.GetOk("tag_policy"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		config.TagPolicyConfig, dx = expandTagPolicy(ctx, v.([]any)[0].(map[string]any))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	if v, ok := d.GetOk("local_emulator"); ok && len(v.([]any)) > 0 {
+		tfMap, _ := v.([]any)[0].(map[string]any)
+		config.LocalEmulator, dx = expandLocalEmulator(ctx, tfMap, true)
+	} else {
+		config.LocalEmulator, dx = expandLocalEmulator(ctx, nil, false)
+	}
+	diags = append(diags, dx...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
@@ -894,8 +1151,241 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 	return nil
 }
 
+// expandDefaultTagsRules returns the default tags rules, in configuration order.
+func expandDefaultTagsRules(ctx context.Context, tfList []any) ([]tftags.DefaultRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var rules []tftags.DefaultRule
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		path := cty.GetAttrPath("default_tags").IndexInt(0).GetAttr("rule").IndexInt(i)
+
+		var rule tftags.DefaultRule
+
+		if v, ok := tfMap["exclude_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+			rule.ExcludeResourceTypes = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["exclude_services"].(*schema.Set); ok && v.Len() > 0 {
+			services, dx := expandDefaultTagsRuleServices(v, path.GetAttr("exclude_services"))
+			diags = append(diags, dx...)
+			rule.ExcludeServices = services
+		}
+
+		if v, ok := tfMap["resource_types"].(*schema.Set); ok && v.Len() > 0 {
+			rule.ResourceTypes = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["services"].(*schema.Set); ok && v.Len() > 0 {
+			services, dx := expandDefaultTagsRuleServices(v, path.GetAttr("services"))
+			diags = append(diags, dx...)
+			rule.Services = services
+		}
+
+		if v, ok := tfMap["tags"].(map[string]any); ok {
+			rule.Tags = tftags.New(ctx, v)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, diags
+}
+
+func expandDefaultTagsRuleServices(tfSet *schema.Set, path cty.Path) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var services []string
+
+	for _, v := range flex.ExpandStringValueSet(tfSet) {
+		service, err := names.ProviderPackageForAlias(v)
+		if err != nil {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(path,
+				"Invalid Attribute Value",
+				fmt.Sprintf("Unknown service %q.", v),
+			))
+			continue
+		}
+
+		services = append(services, service)
+	}
+
+	return services, diags
+}
+
+// expandLocalEmulator returns the local emulator configuration, or nil if the provider is not configured for use with a local emulator.
+// The emulator's endpoint can be set in the `local_emulator` configuration block or via environment variable.
+func expandLocalEmulator(_ context.Context, tfMap map[string]any, configured bool) (*conns.LocalEmulatorConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	endpoint := os.Getenv(conns.LocalEmulatorEndpointEnvVar)
+	if endpoint == "" && !configured {
+		return nil, diags
+	}
+
+	config := &conns.LocalEmulatorConfig{}
+
+	if v, ok := tfMap["account_id"].(string); ok && v != "" {
+		config.AccountID = v
+	}
+
+	if v, ok := tfMap[names.AttrEndpoint].(string); ok && v != "" {
+		endpoint = v
+	}
+
+	if endpoint == "" {
+		return nil, sdkdiag.AppendErrorf(diags, "local_emulator: endpoint must be configured, either in the configuration block or via the %s environment variable", conns.LocalEmulatorEndpointEnvVar)
+	}
+
+	if u, err := url.Parse(endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, sdkdiag.AppendErrorf(diags, "local_emulator: endpoint (%s) must be an absolute HTTP or HTTPS URL", endpoint)
+	}
+
+	config.Endpoint = endpoint
+
+	return config, diags
+}
+
+// expandRetryPolicies returns the per-service retry policies, keyed by service package name.
+func expandRetryPolicies(_ context.Context, tfList []any) (map[string]conns.RetryPolicy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	policies := make(map[string]conns.RetryPolicy)
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		path := cty.GetAttrPath("retry_policy").IndexInt(i).GetAttr("service")
+
+		v, _ := tfMap["service"].(string)
+		service, err := names.ProviderPackageForAlias(v)
+		if err != nil {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(path,
+				"Invalid Attribute Value",
+				fmt.Sprintf("Unknown service %q.", v),
+			))
+			continue
+		}
+
+		if _, ok := policies[service]; ok {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(path,
+				"Invalid Attribute Value",
+				fmt.Sprintf("Duplicate retry policy for service %q.", service),
+			))
+			continue
+		}
+
+		var policy conns.RetryPolicy
+
+		if v, ok := tfMap["max_attempts"].(int); ok {
+			policy.MaxAttempts = v
+		}
+
+		if v, ok := tfMap["max_backoff"].(string); ok && v != "" {
+			policy.MaxBackoff, _ = time.ParseDuration(v)
+		}
+
+		if v, ok := tfMap["retry_mode"].(string); ok && v != "" {
+			policy.RetryMode = aws.RetryMode(v)
+		}
+
+		if v, ok := tfMap["retryable_error_codes"].(*schema.Set); ok && v.Len() > 0 {
+			policy.RetryableErrorCodes = flex.ExpandStringValueSet(v)
+		}
+
+		policies[service] = policy
+	}
+
+	return policies, diags
+}
+
+// expandTagPolicy returns the tag policy configuration, including any rules from an AWS Organizations tag policy file.
+func expandTagPolicy(_ context.Context, tfMap map[string]any) (*tftags.PolicyConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	config := &tftags.PolicyConfig{
+		Enforcement: tftags.PolicyEnforcementError,
+	}
+
+	if v, ok := tfMap["enforcement"].(string); ok && v != "" {
+		config.Enforcement = tftags.PolicyEnforcement(v)
+	}
+
+	if v, ok := tfMap["exempt_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+		config.ExemptResourceTypes = flex.ExpandStringValueSet(v)
+	}
+
+	tfList, _ := tfMap[names.AttrRule].([]any)
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		var rule tftags.PolicyRule
+
+		if v, ok := tfMap[names.AttrKey].(string); ok {
+			rule.Key = v
+		}
+
+		if v, ok := tfMap["ignore_case"].(bool); ok {
+			rule.IgnoreCase = v
+		}
+
+		if v, ok := tfMap["required"].(bool); ok {
+			rule.Required = v
+		}
+
+		if v, ok := tfMap["exempt_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+			rule.ExemptResourceTypes = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["allowed_values"].([]any); ok {
+			for _, v := range flex.ExpandStringValueList(v) {
+				if rule.IgnoreCase {
+					v = "(?i)" + v
+				}
+				re, err := regexp.Compile(v)
+				if err != nil {
+					return nil, sdkdiag.AppendErrorf(diags, "tag_policy: rule (%s) allowed value (%s): %s", rule.Key, v, err)
+				}
+				rule.AllowedValues = append(rule.AllowedValues, re)
+			}
+		}
+
+		config.Rules = append(config.Rules, rule)
+	}
+
+	if v, ok := tfMap["policy_file"].(string); ok && v != "" {
+		document, err := os.ReadFile(v)
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "tag_policy: reading policy file (%s): %s", v, err)
+		}
+
+		rules, err := tftags.PolicyRulesFromOrganizationsTagPolicy(document)
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "tag_policy: policy file (%s): %s", v, err)
+		}
+
+		config.Rules = append(config.Rules, rules...)
+	}
+
+	return config, diags
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
 	var keys, keyPrefixes []interface{}
+	var keyPatterns []*regexp.Regexp
+	var tags []tftags.IgnoreTag
+	var propagated bool
 
 	if tfMap != nil {
 		if v, ok := tfMap["keys"].(*schema.Set); ok {
@@ -904,6 +1394,36 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 		if v, ok := tfMap["key_prefixes"].(*schema.Set); ok {
 			keyPrefixes = v.List()
 		}
+		// Patterns are validated by the schema.
+		if v, ok := tfMap["key_patterns"].(*schema.Set); ok {
+			for _, v := range flex.ExpandStringValueSet(v) {
+				if re, err := regexp.Compile(v); err == nil {
+					keyPatterns = append(keyPatterns, re)
+				}
+			}
+		}
+		if v, ok := tfMap["propagated"].(bool); ok {
+			propagated = v
+		}
+		if v, ok := tfMap["tag"].([]interface{}); ok {
+			for _, tfMapRaw := range v {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				var tag tftags.IgnoreTag
+				if v, ok := tfMap["key_pattern"].(string); ok {
+					tag.KeyPattern, _ = regexp.Compile(v)
+				}
+				if v, ok := tfMap["value_pattern"].(string); ok && v != "" {
+					tag.ValuePattern, _ = regexp.Compile(v)
+				}
+				if tag.KeyPattern != nil {
+					tags = append(tags, tag)
+				}
+			}
+		}
 	}
 
 	if v := os.Getenv(tftags.IgnoreTagsKeysEnvVar); v != "" {
@@ -927,7 +1447,7 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	// - Return nil when no keys or prefixes are set
 	// - For a non-nil return, `keys` or `key_prefixes` should be
 	//   nil if empty (versus a zero-value `KeyValueTags` struct)
-	if len(keys) == 0 && len(keyPrefixes) == 0 {
+	if len(keys) == 0 && len(keyPrefixes) == 0 && len(keyPatterns) == 0 && len(tags) == 0 && !propagated {
 		return nil
 	}
 
@@ -938,6 +1458,9 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	if len(keyPrefixes) > 0 {
 		ignoreConfig.KeyPrefixes = tftags.New(ctx, keyPrefixes)
 	}
+	ignoreConfig.KeyPatterns = keyPatterns
+	ignoreConfig.Propagated = propagated
+	ignoreConfig.Tags = tags
 
 	return ignoreConfig
 }
@@ -6,13 +6,18 @@ package provider
 import (
 	"context"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -307,6 +312,239 @@ func TestExpandDefaultTags(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandRetryPolicies(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testcases := map[string]struct {
+		tfList           []any
+		expectedPolicies map[string]conns.RetryPolicy
+		expectedDiags    diag.Diagnostics
+	}{
+		"empty": {
+			tfList:           []any{},
+			expectedPolicies: map[string]conns.RetryPolicy{},
+		},
+		"full": {
+			tfList: []any{
+				map[string]any{
+					"service":               "route53",
+					"max_attempts":          50,
+					"max_backoff":           "1m",
+					"retry_mode":            "adaptive",
+					"retryable_error_codes": schema.NewSet(schema.HashString, []any{"PriorRequestNotComplete"}),
+				},
+			},
+			expectedPolicies: map[string]conns.RetryPolicy{
+				"route53": {
+					MaxAttempts:         50,
+					MaxBackoff:          time.Minute,
+					RetryMode:           aws.RetryModeAdaptive,
+					RetryableErrorCodes: []string{"PriorRequestNotComplete"},
+				},
+			},
+		},
+		"alias": {
+			tfList: []any{
+				map[string]any{
+					"service":      "applicationautoscaling",
+					"max_attempts": 10,
+				},
+			},
+			expectedPolicies: map[string]conns.RetryPolicy{
+				"appautoscaling": {
+					MaxAttempts: 10,
+				},
+			},
+		},
+		"unknown service": {
+			tfList: []any{
+				map[string]any{
+					"service": "nosuchservice",
+				},
+			},
+			expectedPolicies: map[string]conns.RetryPolicy{},
+			expectedDiags: diag.Diagnostics{
+				errs.NewAttributeErrorDiagnostic(
+					cty.GetAttrPath("retry_policy").IndexInt(0).GetAttr("service"),
+					"Invalid Attribute Value",
+					`Unknown service "nosuchservice".`,
+				),
+			},
+		},
+		"duplicate service": {
+			tfList: []any{
+				map[string]any{
+					"service":      "organizations",
+					"max_attempts": 10,
+				},
+				map[string]any{
+					"service":      "organizations",
+					"max_attempts": 20,
+				},
+			},
+			expectedPolicies: map[string]conns.RetryPolicy{
+				"organizations": {
+					MaxAttempts: 10,
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewAttributeErrorDiagnostic(
+					cty.GetAttrPath("retry_policy").IndexInt(1).GetAttr("service"),
+					"Invalid Attribute Value",
+					`Duplicate retry policy for service "organizations".`,
+				),
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			policies, diags := expandRetryPolicies(ctx, testcase.tfList)
+
+			if diff := cmp.Diff(diags, testcase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+			if diff := cmp.Diff(policies, testcase.expectedPolicies); diff != "" {
+				t.Errorf("unexpected policies difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandDefaultTagsRules(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testcases := map[string]struct {
+		tfList           []any
+		expectedServices [][]string
+		expectedTags     []map[string]string
+		expectedDiags    diag.Diagnostics
+	}{
+		"empty": {
+			tfList: []any{},
+		},
+		"alias": {
+			tfList: []any{
+				map[string]any{
+					"services": schema.NewSet(schema.HashString, []any{"applicationautoscaling"}),
+					"tags":     map[string]any{"Team": "scaling"},
+				},
+				map[string]any{
+					"tags": map[string]any{"Owner": "platform"},
+				},
+			},
+			expectedServices: [][]string{{"appautoscaling"}, nil},
+			expectedTags:     []map[string]string{{"Team": "scaling"}, {"Owner": "platform"}},
+		},
+		"unknown service": {
+			tfList: []any{
+				map[string]any{
+					"exclude_services": schema.NewSet(schema.HashString, []any{"nosuchservice"}),
+					"tags":             map[string]any{"Team": "unknown"},
+				},
+			},
+			expectedServices: [][]string{nil},
+			expectedTags:     []map[string]string{{"Team": "unknown"}},
+			expectedDiags: diag.Diagnostics{
+				errs.NewAttributeErrorDiagnostic(
+					cty.GetAttrPath("default_tags").IndexInt(0).GetAttr("rule").IndexInt(0).GetAttr("exclude_services"),
+					"Invalid Attribute Value",
+					`Unknown service "nosuchservice".`,
+				),
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rules, diags := expandDefaultTagsRules(ctx, testcase.tfList)
+
+			if diff := cmp.Diff(diags, testcase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			var services [][]string
+			var tags []map[string]string
+			for _, rule := range rules {
+				services = append(services, rule.Services)
+				tags = append(tags, rule.Tags.Map())
+			}
+
+			if diff := cmp.Diff(services, testcase.expectedServices); diff != "" {
+				t.Errorf("unexpected services difference: %s", diff)
+			}
+			if diff := cmp.Diff(tags, testcase.expectedTags); diff != "" {
+				t.Errorf("unexpected tags difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandTagPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	filename := filepath.Join(t.TempDir(), "tag-policy.json")
+	if err := os.WriteFile(filename, []byte(`{"tags": {"team": {"tag_key": {"@@assign": "Team"}}}}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	config, diags := expandTagPolicy(ctx, map[string]any{
+		"enforcement":           "warning",
+		"exempt_resource_types": schema.NewSet(schema.HashString, []any{"aws_iam_role"}),
+		"policy_file":           filename,
+		"rule": []any{
+			map[string]any{
+				"allowed_values":        []any{"^prod$"},
+				"exempt_resource_types": schema.NewSet(schema.HashString, []any{}),
+				"ignore_case":           true,
+				"key":                   "Environment",
+				"required":              true,
+			},
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if got, want := config.Enforcement, tftags.PolicyEnforcementWarning; got != want {
+		t.Errorf("Enforcement = %q, want %q", got, want)
+	}
+
+	got := config.Evaluate("aws_instance", tftags.New(ctx, map[string]string{
+		"ENVIRONMENT": "PROD",
+		"team":        "x",
+	}))
+	want := []string{
+		`tag key "team" must be written as "Team"`,
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected violations difference: %s", diff)
+	}
+
+	if v := config.Evaluate("aws_iam_role", nil); len(v) != 0 {
+		t.Errorf("unexpected violations for exempt resource type: %v", v)
+	}
+
+	_, diags = expandTagPolicy(ctx, map[string]any{
+		"rule": []any{
+			map[string]any{
+				"allowed_values": []any{"("},
+				"key":            "Environment",
+			},
+		},
+	})
+	if !diags.HasError() {
+		t.Error("expected error for invalid allowed value")
+	}
+}
+
 func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 	ctx := context.Background()
 	testcases := map[string]struct {
@@ -422,6 +660,51 @@ func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandIgnoreTagsPatterns(t *testing.T) { //nolint:paralleltest
+	ctx := context.Background()
+
+	oldEnv := stashEnv()
+	defer popEnv(oldEnv)
+
+	results := expandIgnoreTags(ctx, map[string]interface{}{
+		"key_patterns": schema.NewSet(schema.HashString, []interface{}{`^vendor:scan:`}),
+		"tag": []interface{}{
+			map[string]interface{}{
+				"key_pattern":   `^backup$`,
+				"value_pattern": `^aws-backup-`,
+			},
+			map[string]interface{}{
+				"key_pattern":   `^cost`,
+				"value_pattern": "",
+			},
+		},
+	})
+
+	if results == nil {
+		t.Fatal("Expected ignore tags config, got nil")
+	}
+
+	var keyPatterns []string
+	for _, re := range results.KeyPatterns {
+		keyPatterns = append(keyPatterns, re.String())
+	}
+	if diff := cmp.Diff(keyPatterns, []string{`^vendor:scan:`}); diff != "" {
+		t.Errorf("Unexpected key patterns diff: %s", diff)
+	}
+
+	var tags [][2]string
+	for _, tag := range results.Tags {
+		var value string
+		if tag.ValuePattern != nil {
+			value = tag.ValuePattern.String()
+		}
+		tags = append(tags, [2]string{tag.KeyPattern.String(), value})
+	}
+	if diff := cmp.Diff(tags, [][2]string{{`^backup$`, `^aws-backup-`}, {`^cost`, ""}}); diff != "" {
+		t.Errorf("Unexpected tags diff: %s", diff)
+	}
+}
+
 func stashEnv() []string {
 	env := os.Environ()
 	os.Clearenv()
@@ -5,10 +5,13 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -66,6 +69,8 @@ func tagsUpdateFunc(ctx context.Context, d schemaResourceData, sp conns.ServiceP
 		}
 	}
 
+	sp, _ = meta.(*conns.AWSClient).TaggingServicePackage(ctx, sp, identifier)
+
 	oldTags := tftags.New(ctx, stateTags)
 	// if tags_all was computed because not wholly known
 	// Merge the resource's configured tags with any provider configured default_tags.
@@ -119,6 +124,8 @@ func tagsReadFunc(ctx context.Context, d schemaResourceData, sp conns.ServicePac
 	// Some old resources may not have the required attribute set after Read:
 	// https://github.com/hashicorp/terraform-provider-aws/issues/31180
 	if identifier != "" {
+		sp, _ := meta.(*conns.AWSClient).TaggingServicePackage(ctx, sp, identifier)
+
 		var err error
 
 		if v, ok := sp.(tftags.ServiceTagLister); ok {
@@ -155,8 +162,14 @@ func tagsReadFunc(ctx context.Context, d schemaResourceData, sp conns.ServicePac
 		}
 	}
 
+	if err := meta.(*conns.AWSClient).SetCloudFormationStackTagsPropagated(ctx, tagsInContext.TagsOut.UnwrapOrDefault()); err != nil {
+		return ctx, sdkdiag.AppendErrorf(diags, "reading tags propagated to %s %s: %s", serviceName, resourceName, err)
+	}
+
 	// Remove any provider configured ignore_tags and system tags from those returned from the service API.
 	toAdd := tagsInContext.TagsOut.UnwrapOrDefault().IgnoreSystem(inContext.ServicePackageName).IgnoreConfig(tagsInContext.IgnoreConfig)
+	// Remove any tags propagated from a parent resource that are not configured.
+	toAdd = tagsInContext.RemoveIgnoredPropagated(toAdd, tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{})))
 
 	// The resource's configured tags can now include duplicate tags that have been configured on the provider.
 	if err := d.Set(names.AttrTags, toAdd.ResolveDuplicates(ctx, tagsInContext.DefaultConfig, tagsInContext.IgnoreConfig, d, names.AttrTags, nil).Map()); err != nil {
@@ -170,3 +183,81 @@ func tagsReadFunc(ctx context.Context, d schemaResourceData, sp conns.ServicePac
 
 	return ctx, diags
 }
+
+// tagsSupportedCustomizeDiff returns a CustomizeDiff function that refuses configured tags on a resource whose service package
+// has no methods to list or update resource tags and for which the Resource Groups Tagging API fallback is not configured.
+// Such tags would otherwise be silently ignored.
+func tagsSupportedCustomizeDiff(spt *types.ServicePackageResourceTags) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		// The resource sets its own tags.
+		if spt.IdentifierAttribute == "" {
+			return nil
+		}
+
+		c, ok := meta.(*conns.AWSClient)
+		if !ok {
+			return nil
+		}
+
+		inContext, ok := conns.FromContext(ctx)
+		if !ok {
+			return nil
+		}
+
+		sp, ok := c.ServicePackages[inContext.ServicePackageName]
+		if !ok || c.SupportsTagging(ctx, sp) {
+			return nil
+		}
+
+		if len(d.Get(names.AttrTags).(map[string]any)) == 0 {
+			return nil
+		}
+
+		return fmt.Errorf("%s does not support tagging: remove %q or set the provider's resource_tagging_api_fallback argument", inContext.TypeName, names.AttrTags)
+	}
+}
+
+// tagPolicyCustomizeDiff evaluates a new resource's tags, or a resource's changed tags, against any provider configured tag policy.
+// As CustomizeDiff cannot return warnings, violations of a policy that is enforced with warnings are logged here
+// and reported by the tags interceptor when the resource is created or updated.
+func tagPolicyCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok {
+		return nil
+	}
+
+	policy := c.TagPolicyConfig(ctx)
+	if policy == nil {
+		return nil
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	if d.Id() != "" && !d.HasChanges(names.AttrTags, names.AttrTagsAll) {
+		return nil
+	}
+
+	if !d.GetRawPlan().GetAttr(names.AttrTags).IsWhollyKnown() {
+		return nil
+	}
+
+	tags := c.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, d.Get(names.AttrTags).(map[string]any)))
+	tags = tags.IgnoreSystem(inContext.ServicePackageName)
+
+	violations := policy.Evaluate(inContext.TypeName, tags)
+	if len(violations) == 0 {
+		return nil
+	}
+
+	if policy.Enforcement == tftags.PolicyEnforcementWarning {
+		tflog.Warn(ctx, "Tag policy violations", map[string]any{
+			"violations": violations,
+		})
+		return nil
+	}
+
+	return fmt.Errorf("tag policy violations:\n  - %s", strings.Join(violations, "\n  - "))
+}
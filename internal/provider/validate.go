@@ -28,6 +28,22 @@ func validAssumeRoleDuration(v interface{}, k string) (ws []string, errors []err
 	return
 }
 
+// validRetryPolicyMaxBackoff validates a string can be parsed as a positive time.Duration
+func validRetryPolicyMaxBackoff(v interface{}, k string) (ws []string, errors []error) {
+	duration, err := time.ParseDuration(v.(string))
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q cannot be parsed as a duration: %w", k, err))
+		return
+	}
+
+	if duration <= 0 {
+		errors = append(errors, fmt.Errorf("duration %q must be greater than zero", k))
+	}
+
+	return
+}
+
 var validAssumeRoleSessionName = validation.All(
 	validation.StringLenBetween(2, 64),
 	validation.StringMatch(regexache.MustCompile(`[\w+=,.@\-]*`), ""),
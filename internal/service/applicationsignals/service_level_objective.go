@@ -0,0 +1,813 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/applicationsignals/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_applicationsignals_service_level_objective", name="Service Level Objective")
+// @Tags(identifierAttribute="arn")
+func newServiceLevelObjectiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &serviceLevelObjectiveResource{}
+
+	return r, nil
+}
+
+type serviceLevelObjectiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*serviceLevelObjectiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_applicationsignals_service_level_objective"
+}
+
+func (r *serviceLevelObjectiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1024),
+				},
+			},
+			"evaluation_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EvaluationType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"last_updated_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"burn_rate_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[burnRateConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(10),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"look_back_window_minutes": schema.Int32Attribute{
+							Required: true,
+							Validators: []validator.Int32{
+								int32validator.Between(1, 10080),
+							},
+						},
+					},
+				},
+			},
+			"goal": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[goalModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"attainment_goal": schema.Float64Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Float64{
+								float64planmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.Float64{
+								float64validator.Between(0, 100),
+							},
+						},
+						"warning_threshold": schema.Float64Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Float64{
+								float64planmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.Float64{
+								float64validator.Between(0, 100),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						names.AttrInterval: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[intervalModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"calendar_interval": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[calendarIntervalModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+											listvalidator.ExactlyOneOf(
+												path.MatchRelative().AtParent().AtName("calendar_interval"),
+												path.MatchRelative().AtParent().AtName("rolling_interval"),
+											),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrDuration: schema.Int32Attribute{
+													Required: true,
+													Validators: []validator.Int32{
+														int32validator.AtLeast(1),
+													},
+												},
+												"duration_unit": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.DurationUnit](),
+													Required:   true,
+												},
+												names.AttrStartTime: schema.StringAttribute{
+													CustomType: timetypes.RFC3339Type{},
+													Required:   true,
+												},
+											},
+										},
+									},
+									"rolling_interval": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[rollingIntervalModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrDuration: schema.Int32Attribute{
+													Required: true,
+													Validators: []validator.Int32{
+														int32validator.AtLeast(1),
+													},
+												},
+												"duration_unit": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.DurationUnit](),
+													Required:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"request_based_sli": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[requestBasedSLIModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"comparison_operator": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ServiceLevelIndicatorComparisonOperator](),
+							Optional:   true,
+						},
+						"metric_threshold": schema.Float64Attribute{
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"request_based_sli_metric": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[requestBasedSLIMetricModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"key_attributes": schema.MapAttribute{
+										CustomType:  fwtypes.MapOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									"metric_type": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.ServiceLevelIndicatorMetricType](),
+										Optional:   true,
+									},
+									"operation_name": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"monitored_request_count_metric": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[monitoredRequestCountMetricModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												"bad_count_metric": metricDataQueryBlock(ctx,
+													listvalidator.ExactlyOneOf(
+														path.MatchRelative().AtParent().AtName("bad_count_metric"),
+														path.MatchRelative().AtParent().AtName("good_count_metric"),
+													),
+												),
+												"good_count_metric": metricDataQueryBlock(ctx),
+											},
+										},
+									},
+									"total_request_count_metric": metricDataQueryBlock(ctx),
+								},
+							},
+						},
+					},
+				},
+			},
+			"sli": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[sliModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"comparison_operator": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ServiceLevelIndicatorComparisonOperator](),
+							Required:   true,
+						},
+						"metric_threshold": schema.Float64Attribute{
+							Required: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"sli_metric": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[sliMetricModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"key_attributes": schema.MapAttribute{
+										CustomType:  fwtypes.MapOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									"metric_type": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.ServiceLevelIndicatorMetricType](),
+										Optional:   true,
+									},
+									"operation_name": schema.StringAttribute{
+										Optional: true,
+									},
+									"period_seconds": schema.Int32Attribute{
+										Optional: true,
+										Validators: []validator.Int32{
+											int32validator.Between(60, 900),
+										},
+									},
+									"statistic": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"metric_data_query": metricDataQueryBlock(ctx),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func metricDataQueryBlock(ctx context.Context, validators ...validator.List) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[metricDataQueryModel](ctx),
+		Validators: validators,
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrAccountID: schema.StringAttribute{
+					Optional: true,
+				},
+				names.AttrExpression: schema.StringAttribute{
+					Optional: true,
+				},
+				names.AttrID: schema.StringAttribute{
+					Required: true,
+				},
+				"label": schema.StringAttribute{
+					Optional: true,
+				},
+				"period": schema.Int32Attribute{
+					Optional: true,
+				},
+				"return_data": schema.BoolAttribute{
+					Optional: true,
+					Computed: true,
+					PlanModifiers: []planmodifier.Bool{
+						boolplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"metric_stat": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[metricStatModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"period": schema.Int32Attribute{
+								Required: true,
+							},
+							"stat": schema.StringAttribute{
+								Required: true,
+							},
+							names.AttrUnit: schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.StandardUnit](),
+								Optional:   true,
+							},
+						},
+						Blocks: map[string]schema.Block{
+							"metric": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[metricModel](ctx),
+								Validators: []validator.List{
+									listvalidator.IsRequired(),
+									listvalidator.SizeAtLeast(1),
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										names.AttrMetricName: schema.StringAttribute{
+											Optional: true,
+										},
+										names.AttrNamespace: schema.StringAttribute{
+											Optional: true,
+										},
+									},
+									Blocks: map[string]schema.Block{
+										"dimension": schema.ListNestedBlock{
+											CustomType: fwtypes.NewListNestedObjectTypeOf[dimensionModel](ctx),
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(30),
+											},
+											NestedObject: schema.NestedBlockObject{
+												Attributes: map[string]schema.Attribute{
+													names.AttrName: schema.StringAttribute{
+														Required: true,
+													},
+													names.AttrValue: schema.StringAttribute{
+														Required: true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *serviceLevelObjectiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data serviceLevelObjectiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &applicationsignals.CreateServiceLevelObjectiveInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input, serviceLevelObjectiveFlexOptions...)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateServiceLevelObjective(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Application Signals Service Level Objective (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(name)
+
+	prior := data
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.Slo, &data, serviceLevelObjectiveFlexOptions...)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(data.restoreUnreturnedValues(ctx, prior)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *serviceLevelObjectiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data serviceLevelObjectiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	output, err := findServiceLevelObjectiveByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Application Signals Service Level Objective (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	prior := data
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, serviceLevelObjectiveFlexOptions...)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(data.restoreUnreturnedValues(ctx, prior)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *serviceLevelObjectiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new serviceLevelObjectiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	diff, d := fwflex.Calculate(ctx, new, old)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		input := &applicationsignals.UpdateServiceLevelObjectiveInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input, serviceLevelObjectiveFlexOptions...)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.Id = new.ID.ValueStringPointer()
+		// Removed burn rate configurations are only cleared if an empty list is sent.
+		if input.BurnRateConfigurations == nil {
+			input.BurnRateConfigurations = []awstypes.BurnRateConfiguration{}
+		}
+
+		output, err := conn.UpdateServiceLevelObjective(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Application Signals Service Level Objective (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		prior := new
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output.Slo, &new, serviceLevelObjectiveFlexOptions...)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		response.Diagnostics.Append(new.restoreUnreturnedValues(ctx, prior)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		new.LastUpdatedTime = old.LastUpdatedTime
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *serviceLevelObjectiveResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data serviceLevelObjectiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	_, err := conn.DeleteServiceLevelObjective(ctx, &applicationsignals.DeleteServiceLevelObjectiveInput{
+		Id: data.ID.ValueStringPointer(),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Application Signals Service Level Objective (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *serviceLevelObjectiveResource) ConfigValidators(context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("request_based_sli"),
+			path.MatchRoot("sli"),
+		),
+	}
+}
+
+func (r *serviceLevelObjectiveResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+// The API's input shapes are named "...Config" while its output shapes are not.
+var serviceLevelObjectiveFlexOptions = []fwflex.AutoFlexOptionsFunc{
+	fwflex.WithFieldNameSuffix("Config"),
+	fwflex.WithUnionMembers(
+		&awstypes.IntervalMemberCalendarInterval{},
+		&awstypes.IntervalMemberRollingInterval{},
+		&awstypes.MonitoredRequestCountMetricDataQueriesMemberBadCountMetric{},
+		&awstypes.MonitoredRequestCountMetricDataQueriesMemberGoodCountMetric{},
+	),
+}
+
+func findServiceLevelObjectiveByID(ctx context.Context, conn *applicationsignals.Client, id string) (*awstypes.ServiceLevelObjective, error) {
+	input := &applicationsignals.GetServiceLevelObjectiveInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetServiceLevelObjective(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Slo == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Slo, nil
+}
+
+type serviceLevelObjectiveResourceModel struct {
+	ARN                    types.String                                                `tfsdk:"arn"`
+	BurnRateConfigurations fwtypes.ListNestedObjectValueOf[burnRateConfigurationModel] `tfsdk:"burn_rate_configuration"`
+	CreatedTime            timetypes.RFC3339                                           `tfsdk:"created_time"`
+	Description            types.String                                                `tfsdk:"description"`
+	EvaluationType         fwtypes.StringEnum[awstypes.EvaluationType]                 `tfsdk:"evaluation_type"`
+	Goal                   fwtypes.ListNestedObjectValueOf[goalModel]                  `tfsdk:"goal"`
+	ID                     types.String                                                `tfsdk:"id" autoflex:"-"`
+	LastUpdatedTime        timetypes.RFC3339                                           `tfsdk:"last_updated_time"`
+	Name                   types.String                                                `tfsdk:"name"`
+	RequestBasedSLI        fwtypes.ListNestedObjectValueOf[requestBasedSLIModel]       `tfsdk:"request_based_sli"`
+	SLI                    fwtypes.ListNestedObjectValueOf[sliModel]                   `tfsdk:"sli"`
+	Tags                   tftags.Map                                                  `tfsdk:"tags"`
+	TagsAll                tftags.Map                                                  `tfsdk:"tags_all"`
+}
+
+// restoreUnreturnedValues copies into the model those values from the prior plan or state
+// that the API either doesn't return or returns only in a generated form.
+func (m *serviceLevelObjectiveResourceModel) restoreUnreturnedValues(ctx context.Context, prior serviceLevelObjectiveResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The API always returns a goal, defaulted if not configured.
+	if prior.Goal.IsNull() {
+		m.Goal = prior.Goal
+	}
+
+	if sli, priorSLI := m.SLI, prior.SLI; !sli.IsNull() && !priorSLI.IsNull() {
+		sliData, d := sli.ToPtr(ctx)
+		diags.Append(d...)
+		priorSLIData, d := priorSLI.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		sliMetricData, d := sliData.SLIMetric.ToPtr(ctx)
+		diags.Append(d...)
+		priorSLIMetricData, d := priorSLIData.SLIMetric.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if sliMetricData != nil && priorSLIMetricData != nil {
+			sliMetricData.PeriodSeconds = priorSLIMetricData.PeriodSeconds
+			sliMetricData.Statistic = priorSLIMetricData.Statistic
+			// Metric data queries are generated for service operation SLIs.
+			if len(priorSLIMetricData.MetricDataQueries.Elements()) == 0 {
+				sliMetricData.MetricDataQueries = priorSLIMetricData.MetricDataQueries
+			}
+
+			sliData.SLIMetric = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, sliMetricData)
+			m.SLI = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, sliData)
+		}
+	}
+
+	if sli, priorSLI := m.RequestBasedSLI, prior.RequestBasedSLI; !sli.IsNull() && !priorSLI.IsNull() {
+		sliData, d := sli.ToPtr(ctx)
+		diags.Append(d...)
+		priorSLIData, d := priorSLI.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		sliMetricData, d := sliData.RequestBasedSLIMetric.ToPtr(ctx)
+		diags.Append(d...)
+		priorSLIMetricData, d := priorSLIData.RequestBasedSLIMetric.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if sliMetricData != nil && priorSLIMetricData != nil {
+			// Request count metrics are generated for service operation SLIs.
+			if priorSLIMetricData.MonitoredRequestCountMetric.IsNull() {
+				sliMetricData.MonitoredRequestCountMetric = priorSLIMetricData.MonitoredRequestCountMetric
+			}
+			if len(priorSLIMetricData.TotalRequestCountMetric.Elements()) == 0 {
+				sliMetricData.TotalRequestCountMetric = priorSLIMetricData.TotalRequestCountMetric
+			}
+
+			sliData.RequestBasedSLIMetric = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, sliMetricData)
+			m.RequestBasedSLI = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, sliData)
+		}
+	}
+
+	return diags
+}
+
+type burnRateConfigurationModel struct {
+	LookBackWindowMinutes types.Int32 `tfsdk:"look_back_window_minutes"`
+}
+
+type goalModel struct {
+	AttainmentGoal   types.Float64                                  `tfsdk:"attainment_goal"`
+	Interval         fwtypes.ListNestedObjectValueOf[intervalModel] `tfsdk:"interval"`
+	WarningThreshold types.Float64                                  `tfsdk:"warning_threshold"`
+}
+
+// intervalModel models the awstypes.Interval union.
+type intervalModel struct {
+	CalendarInterval fwtypes.ListNestedObjectValueOf[calendarIntervalModel] `tfsdk:"calendar_interval"`
+	RollingInterval  fwtypes.ListNestedObjectValueOf[rollingIntervalModel]  `tfsdk:"rolling_interval"`
+}
+
+type calendarIntervalModel struct {
+	Duration     types.Int32                               `tfsdk:"duration"`
+	DurationUnit fwtypes.StringEnum[awstypes.DurationUnit] `tfsdk:"duration_unit"`
+	StartTime    timetypes.RFC3339                         `tfsdk:"start_time"`
+}
+
+type rollingIntervalModel struct {
+	Duration     types.Int32                               `tfsdk:"duration"`
+	DurationUnit fwtypes.StringEnum[awstypes.DurationUnit] `tfsdk:"duration_unit"`
+}
+
+type requestBasedSLIModel struct {
+	ComparisonOperator    fwtypes.StringEnum[awstypes.ServiceLevelIndicatorComparisonOperator] `tfsdk:"comparison_operator"`
+	MetricThreshold       types.Float64                                                        `tfsdk:"metric_threshold"`
+	RequestBasedSLIMetric fwtypes.ListNestedObjectValueOf[requestBasedSLIMetricModel]          `tfsdk:"request_based_sli_metric"`
+}
+
+type requestBasedSLIMetricModel struct {
+	KeyAttributes               fwtypes.MapValueOf[types.String]                                  `tfsdk:"key_attributes"`
+	MetricType                  fwtypes.StringEnum[awstypes.ServiceLevelIndicatorMetricType]      `tfsdk:"metric_type"`
+	MonitoredRequestCountMetric fwtypes.ListNestedObjectValueOf[monitoredRequestCountMetricModel] `tfsdk:"monitored_request_count_metric"`
+	OperationName               types.String                                                      `tfsdk:"operation_name"`
+	TotalRequestCountMetric     fwtypes.ListNestedObjectValueOf[metricDataQueryModel]             `tfsdk:"total_request_count_metric"`
+}
+
+// monitoredRequestCountMetricModel models the awstypes.MonitoredRequestCountMetricDataQueries union.
+type monitoredRequestCountMetricModel struct {
+	BadCountMetric  fwtypes.ListNestedObjectValueOf[metricDataQueryModel] `tfsdk:"bad_count_metric"`
+	GoodCountMetric fwtypes.ListNestedObjectValueOf[metricDataQueryModel] `tfsdk:"good_count_metric"`
+}
+
+type sliModel struct {
+	ComparisonOperator fwtypes.StringEnum[awstypes.ServiceLevelIndicatorComparisonOperator] `tfsdk:"comparison_operator"`
+	MetricThreshold    types.Float64                                                        `tfsdk:"metric_threshold"`
+	SLIMetric          fwtypes.ListNestedObjectValueOf[sliMetricModel]                      `tfsdk:"sli_metric"`
+}
+
+type sliMetricModel struct {
+	KeyAttributes     fwtypes.MapValueOf[types.String]                             `tfsdk:"key_attributes"`
+	MetricDataQueries fwtypes.ListNestedObjectValueOf[metricDataQueryModel]        `tfsdk:"metric_data_query"`
+	MetricType        fwtypes.StringEnum[awstypes.ServiceLevelIndicatorMetricType] `tfsdk:"metric_type"`
+	OperationName     types.String                                                 `tfsdk:"operation_name"`
+	PeriodSeconds     types.Int32                                                  `tfsdk:"period_seconds"`
+	Statistic         types.String                                                 `tfsdk:"statistic"`
+}
+
+type metricDataQueryModel struct {
+	AccountID  types.String                                     `tfsdk:"account_id"`
+	Expression types.String                                     `tfsdk:"expression"`
+	ID         types.String                                     `tfsdk:"id"`
+	Label      types.String                                     `tfsdk:"label"`
+	MetricStat fwtypes.ListNestedObjectValueOf[metricStatModel] `tfsdk:"metric_stat"`
+	Period     types.Int32                                      `tfsdk:"period"`
+	ReturnData types.Bool                                       `tfsdk:"return_data"`
+}
+
+type metricStatModel struct {
+	Metric fwtypes.ListNestedObjectValueOf[metricModel] `tfsdk:"metric"`
+	Period types.Int32                                  `tfsdk:"period"`
+	Stat   types.String                                 `tfsdk:"stat"`
+	Unit   fwtypes.StringEnum[awstypes.StandardUnit]    `tfsdk:"unit"`
+}
+
+type metricModel struct {
+	Dimensions fwtypes.ListNestedObjectValueOf[dimensionModel] `tfsdk:"dimension"`
+	MetricName types.String                                    `tfsdk:"metric_name"`
+	Namespace  types.String                                    `tfsdk:"namespace"`
+}
+
+type dimensionModel struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
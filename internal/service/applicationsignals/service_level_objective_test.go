@@ -0,0 +1,577 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/applicationsignals/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfapplicationsignals "github.com/hashicorp/terraform-provider-aws/internal/service/applicationsignals"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccApplicationSignalsServiceLevelObjective_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var slo awstypes.ServiceLevelObjective
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName, &slo),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "burn_rate_configuration.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedTime),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_type", "PeriodBased"),
+					resource.TestCheckResourceAttr(resourceName, "goal.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrID, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "request_based_sli.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "sli.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sli.0.comparison_operator", "LessThan"),
+					resource.TestCheckResourceAttr(resourceName, "sli.0.metric_threshold", "80"),
+					resource.TestCheckResourceAttr(resourceName, "sli.0.sli_metric.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sli.0.sli_metric.0.metric_data_query.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sli.0.sli_metric.0.metric_data_query.0.id", "cpu"),
+					resource.TestCheckResourceAttr(resourceName, "sli.0.sli_metric.0.metric_data_query.0.metric_stat.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sli.0.sli_metric.0.metric_data_query.0.metric_stat.0.metric.0.metric_name", "CPUUtilization"),
+					resource.TestCheckResourceAttr(resourceName, "sli.0.sli_metric.0.metric_data_query.0.metric_stat.0.metric.0.namespace", "AWS/EC2"),
+					resource.TestCheckResourceAttr(resourceName, "sli.0.sli_metric.0.metric_data_query.0.metric_stat.0.period", "60"),
+					resource.TestCheckResourceAttr(resourceName, "sli.0.sli_metric.0.metric_data_query.0.metric_stat.0.stat", "Average"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccApplicationSignalsServiceLevelObjective_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var slo awstypes.ServiceLevelObjective
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName, &slo),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfapplicationsignals.ResourceServiceLevelObjective, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccApplicationSignalsServiceLevelObjective_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var slo awstypes.ServiceLevelObjective
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName, &slo),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccServiceLevelObjectiveConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName, &slo),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccServiceLevelObjectiveConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName, &slo),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApplicationSignalsServiceLevelObjective_goal(t *testing.T) {
+	ctx := acctest.Context(t)
+	var slo awstypes.ServiceLevelObjective
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_goalRolling(rName, "first", 99.9, 7),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName, &slo),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "first"),
+					resource.TestCheckResourceAttr(resourceName, "goal.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.attainment_goal", "99.9"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.calendar_interval.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.rolling_interval.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.rolling_interval.0.duration", "7"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.rolling_interval.0.duration_unit", "DAY"),
+					resource.TestCheckResourceAttrSet(resourceName, "goal.0.warning_threshold"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccServiceLevelObjectiveConfig_goalRolling(rName, "second", 99.5, 14),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName, &slo),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "second"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.attainment_goal", "99.5"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.rolling_interval.0.duration", "14"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApplicationSignalsServiceLevelObjective_requestBasedSLI(t *testing.T) {
+	ctx := acctest.Context(t)
+	var slo awstypes.ServiceLevelObjective
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_requestBasedSLI(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName, &slo),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_type", "RequestBased"),
+					resource.TestCheckResourceAttr(resourceName, "request_based_sli.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "request_based_sli.0.request_based_sli_metric.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "request_based_sli.0.request_based_sli_metric.0.monitored_request_count_metric.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "request_based_sli.0.request_based_sli_metric.0.monitored_request_count_metric.0.bad_count_metric.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "request_based_sli.0.request_based_sli_metric.0.monitored_request_count_metric.0.good_count_metric.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "request_based_sli.0.request_based_sli_metric.0.total_request_count_metric.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sli.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccApplicationSignalsServiceLevelObjective_burnRateConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var slo awstypes.ServiceLevelObjective
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_burnRateConfiguration1(rName, 60),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName, &slo),
+					resource.TestCheckResourceAttr(resourceName, "burn_rate_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "burn_rate_configuration.0.look_back_window_minutes", "60"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccServiceLevelObjectiveConfig_burnRateConfiguration2(rName, 5, 60),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName, &slo),
+					resource.TestCheckResourceAttr(resourceName, "burn_rate_configuration.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "burn_rate_configuration.0.look_back_window_minutes", "5"),
+					resource.TestCheckResourceAttr(resourceName, "burn_rate_configuration.1.look_back_window_minutes", "60"),
+				),
+			},
+			{
+				Config: testAccServiceLevelObjectiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName, &slo),
+					resource.TestCheckResourceAttr(resourceName, "burn_rate_configuration.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckServiceLevelObjectiveDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationSignalsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_applicationsignals_service_level_objective" {
+				continue
+			}
+
+			_, err := tfapplicationsignals.FindServiceLevelObjectiveByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Application Signals Service Level Objective %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckServiceLevelObjectiveExists(ctx context.Context, n string, v *awstypes.ServiceLevelObjective) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationSignalsClient(ctx)
+
+		output, err := tfapplicationsignals.FindServiceLevelObjectiveByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccServiceLevelObjectiveConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name = %[1]q
+
+  sli {
+    comparison_operator = "LessThan"
+    metric_threshold    = 80
+
+    sli_metric {
+      metric_data_query {
+        id = "cpu"
+
+        metric_stat {
+          period = 60
+          stat   = "Average"
+
+          metric {
+            metric_name = "CPUUtilization"
+            namespace   = "AWS/EC2"
+          }
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccServiceLevelObjectiveConfig_burnRateConfiguration1(rName string, lookBackWindowMinutes1 int) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name = %[1]q
+
+  burn_rate_configuration {
+    look_back_window_minutes = %[2]d
+  }
+
+  sli {
+    comparison_operator = "LessThan"
+    metric_threshold    = 80
+
+    sli_metric {
+      metric_data_query {
+        id = "cpu"
+
+        metric_stat {
+          period = 60
+          stat   = "Average"
+
+          metric {
+            metric_name = "CPUUtilization"
+            namespace   = "AWS/EC2"
+          }
+        }
+      }
+    }
+  }
+}
+`, rName, lookBackWindowMinutes1)
+}
+
+func testAccServiceLevelObjectiveConfig_burnRateConfiguration2(rName string, lookBackWindowMinutes1, lookBackWindowMinutes2 int) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name = %[1]q
+
+  burn_rate_configuration {
+    look_back_window_minutes = %[2]d
+  }
+
+  burn_rate_configuration {
+    look_back_window_minutes = %[3]d
+  }
+
+  sli {
+    comparison_operator = "LessThan"
+    metric_threshold    = 80
+
+    sli_metric {
+      metric_data_query {
+        id = "cpu"
+
+        metric_stat {
+          period = 60
+          stat   = "Average"
+
+          metric {
+            metric_name = "CPUUtilization"
+            namespace   = "AWS/EC2"
+          }
+        }
+      }
+    }
+  }
+}
+`, rName, lookBackWindowMinutes1, lookBackWindowMinutes2)
+}
+
+func testAccServiceLevelObjectiveConfig_goalRolling(rName, description string, attainmentGoal float64, duration int) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name        = %[1]q
+  description = %[2]q
+
+  goal {
+    attainment_goal = %[3]g
+
+    interval {
+      rolling_interval {
+        duration      = %[4]d
+        duration_unit = "DAY"
+      }
+    }
+  }
+
+  sli {
+    comparison_operator = "LessThan"
+    metric_threshold    = 80
+
+    sli_metric {
+      metric_data_query {
+        id = "cpu"
+
+        metric_stat {
+          period = 60
+          stat   = "Average"
+
+          metric {
+            metric_name = "CPUUtilization"
+            namespace   = "AWS/EC2"
+          }
+        }
+      }
+    }
+  }
+}
+`, rName, description, attainmentGoal, duration)
+}
+
+func testAccServiceLevelObjectiveConfig_requestBasedSLI(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name = %[1]q
+
+  request_based_sli {
+    request_based_sli_metric {
+      monitored_request_count_metric {
+        good_count_metric {
+          id = "good"
+
+          metric_stat {
+            period = 60
+            stat   = "Sum"
+
+            metric {
+              metric_name = "Invocations"
+              namespace   = "AWS/Lambda"
+            }
+          }
+        }
+      }
+
+      total_request_count_metric {
+        id = "total"
+
+        metric_stat {
+          period = 60
+          stat   = "Sum"
+
+          metric {
+            metric_name = "Invocations"
+            namespace   = "AWS/Lambda"
+          }
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccServiceLevelObjectiveConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name = %[1]q
+
+  sli {
+    comparison_operator = "LessThan"
+    metric_threshold    = 80
+
+    sli_metric {
+      metric_data_query {
+        id = "cpu"
+
+        metric_stat {
+          period = 60
+          stat   = "Average"
+
+          metric {
+            metric_name = "CPUUtilization"
+            namespace   = "AWS/EC2"
+          }
+        }
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccServiceLevelObjectiveConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name = %[1]q
+
+  sli {
+    comparison_operator = "LessThan"
+    metric_threshold    = 80
+
+    sli_metric {
+      metric_data_query {
+        id = "cpu"
+
+        metric_stat {
+          period = 60
+          stat   = "Average"
+
+          metric {
+            metric_name = "CPUUtilization"
+            namespace   = "AWS/EC2"
+          }
+        }
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
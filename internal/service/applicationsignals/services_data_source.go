@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/applicationsignals/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// Services discovered within the previous day are returned by default.
+	defaultServicesLookback = 24 * time.Hour
+)
+
+// @FrameworkDataSource("aws_applicationsignals_services", name="Services")
+func newServicesDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &servicesDataSource{}, nil
+}
+
+type servicesDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*servicesDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_applicationsignals_services"
+}
+
+func (d *servicesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"end_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+				Computed:   true,
+			},
+			names.AttrID:        framework.IDAttribute(),
+			"service_summaries": framework.DataSourceComputedListOfObjectAttribute[serviceSummaryModel](ctx),
+			names.AttrStartTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (d *servicesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data servicesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ApplicationSignalsClient(ctx)
+
+	if data.EndTime.IsNull() {
+		data.EndTime = timetypes.NewRFC3339TimeValue(time.Now())
+	}
+	if data.StartTime.IsNull() {
+		endTime, diags := data.EndTime.ValueRFC3339Time()
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		data.StartTime = timetypes.NewRFC3339TimeValue(endTime.Add(-defaultServicesLookback))
+	}
+
+	input := &applicationsignals.ListServicesInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	services, err := findServices(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("listing Application Signals Services", err.Error())
+
+		return
+	}
+
+	output := &applicationsignals.ListServicesOutput{
+		ServiceSummaries: services,
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(d.Meta().Region)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findServices(ctx context.Context, conn *applicationsignals.Client, input *applicationsignals.ListServicesInput) ([]awstypes.ServiceSummary, error) {
+	var output []awstypes.ServiceSummary
+
+	pages := applicationsignals.NewListServicesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ServiceSummaries...)
+	}
+
+	return output, nil
+}
+
+type servicesDataSourceModel struct {
+	EndTime          timetypes.RFC3339                                    `tfsdk:"end_time"`
+	ID               types.String                                         `tfsdk:"id"`
+	ServiceSummaries fwtypes.ListNestedObjectValueOf[serviceSummaryModel] `tfsdk:"service_summaries"`
+	StartTime        timetypes.RFC3339                                    `tfsdk:"start_time"`
+}
+
+type serviceSummaryModel struct {
+	KeyAttributes    fwtypes.MapValueOf[types.String]                      `tfsdk:"key_attributes"`
+	MetricReferences fwtypes.ListNestedObjectValueOf[metricReferenceModel] `tfsdk:"metric_references"`
+}
+
+type metricReferenceModel struct {
+	Dimensions fwtypes.ListNestedObjectValueOf[dimensionModel] `tfsdk:"dimensions"`
+	MetricName types.String                                    `tfsdk:"metric_name"`
+	MetricType types.String                                    `tfsdk:"metric_type"`
+	Namespace  types.String                                    `tfsdk:"namespace"`
+}
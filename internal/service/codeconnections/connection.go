@@ -0,0 +1,330 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeconnections
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codeconnections"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/codeconnections/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_codeconnections_connection", name="Connection")
+// @Tags(identifierAttribute="arn")
+func newConnectionResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &connectionResource{}, nil
+}
+
+type connectionResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpUpdate[connectionResourceModel]
+	framework.WithImportByID
+}
+
+func (*connectionResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_codeconnections_connection"
+}
+
+func (r *connectionResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"connection_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ConnectionStatus](),
+				Computed:   true,
+			},
+			"host_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("provider_type")),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+				},
+			},
+			"owner_account_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"provider_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ProviderType](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (r *connectionResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			SourceSchema: legacyConnectionResourceSchemaV0(ctx),
+			StateMover:   r.moveStateResourceConnection,
+		},
+	}
+}
+
+func (r *connectionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data connectionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CodeConnectionsClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &codeconnections.CreateConnectionInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input, fwflex.WithFieldNamePrefix("Connection"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateConnection(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating CodeConnections Connection (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	arn := aws.ToString(output.ConnectionArn)
+	data.ID = types.StringValue(arn)
+
+	connection, err := findConnectionByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading CodeConnections Connection (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, connection, &data, fwflex.WithFieldNamePrefix("Connection"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *connectionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data connectionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CodeConnectionsClient(ctx)
+
+	output, err := findConnectionByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CodeConnections Connection (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithFieldNamePrefix("Connection"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *connectionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data connectionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CodeConnectionsClient(ctx)
+
+	_, err := conn.DeleteConnection(ctx, &codeconnections.DeleteConnectionInput{
+		ConnectionArn: data.ID.ValueStringPointer(),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting CodeConnections Connection (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *connectionResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+// moveStateResourceConnection transforms the state of an `aws_codestarconnections_connection` resource to this resource's schema.
+func (r *connectionResource) moveStateResourceConnection(ctx context.Context, request resource.MoveStateRequest, response *resource.MoveStateResponse) {
+	if request.SourceTypeName != "aws_codestarconnections_connection" {
+		return
+	}
+
+	if request.SourceSchemaVersion != 0 {
+		return
+	}
+
+	if !strings.HasSuffix(request.SourceProviderAddress, "hashicorp/aws") {
+		return
+	}
+
+	var source legacyConnectionResourceModel
+	response.Diagnostics.Append(request.SourceState.Get(ctx, &source)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Connection ARNs are interchangeable between the CodeStar Connections and CodeConnections APIs.
+	target := &connectionResourceModel{
+		ARN:              source.ARN,
+		ConnectionStatus: source.ConnectionStatus,
+		HostARN:          fwtypes.ARNValue(source.HostARN.ValueString()),
+		ID:               source.ID,
+		Name:             source.Name,
+		OwnerAccountID:   types.StringNull(),
+		ProviderType:     source.ProviderType,
+		Tags:             source.Tags,
+		TagsAll:          source.TagsAll,
+	}
+	if source.HostARN.IsNull() || source.HostARN.ValueString() == "" {
+		target.HostARN = fwtypes.ARNNull()
+	}
+
+	response.Diagnostics.Append(response.TargetState.Set(ctx, target)...)
+}
+
+func findConnectionByARN(ctx context.Context, conn *codeconnections.Client, arn string) (*awstypes.Connection, error) {
+	input := &codeconnections.GetConnectionInput{
+		ConnectionArn: aws.String(arn),
+	}
+
+	output, err := conn.GetConnection(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Connection == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Connection, nil
+}
+
+type connectionResourceModel struct {
+	ARN              types.String                                  `tfsdk:"arn"`
+	ConnectionStatus fwtypes.StringEnum[awstypes.ConnectionStatus] `tfsdk:"connection_status"`
+	HostARN          fwtypes.ARN                                   `tfsdk:"host_arn"`
+	ID               types.String                                  `tfsdk:"id"`
+	Name             types.String                                  `tfsdk:"name"`
+	OwnerAccountID   types.String                                  `tfsdk:"owner_account_id"`
+	ProviderType     fwtypes.StringEnum[awstypes.ProviderType]     `tfsdk:"provider_type"`
+	Tags             tftags.Map                                    `tfsdk:"tags"`
+	TagsAll          tftags.Map                                    `tfsdk:"tags_all"`
+}
+
+// legacyConnectionResourceSchemaV0 returns version 0 of the schema for the `aws_codestarconnections_connection` resource.
+func legacyConnectionResourceSchemaV0(context.Context) *schema.Schema {
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			"connection_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ConnectionStatus](),
+				Computed:   true,
+			},
+			"host_arn": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			"provider_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ProviderType](),
+				Optional:   true,
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Version: 0,
+	}
+}
+
+type legacyConnectionResourceModel struct {
+	ARN              types.String                                  `tfsdk:"arn"`
+	ConnectionStatus fwtypes.StringEnum[awstypes.ConnectionStatus] `tfsdk:"connection_status"`
+	HostARN          types.String                                  `tfsdk:"host_arn"`
+	ID               types.String                                  `tfsdk:"id"`
+	Name             types.String                                  `tfsdk:"name"`
+	ProviderType     fwtypes.StringEnum[awstypes.ProviderType]     `tfsdk:"provider_type"`
+	Tags             tftags.Map                                    `tfsdk:"tags"`
+	TagsAll          tftags.Map                                    `tfsdk:"tags_all"`
+}
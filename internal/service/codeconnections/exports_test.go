@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeconnections
+
+// Exports for use in tests only.
+var (
+	ResourceConnection        = newConnectionResource
+	ResourceHost              = newHostResource
+	ResourceRepositoryLink    = newRepositoryLinkResource
+	ResourceSyncConfiguration = newSyncConfigurationResource
+
+	FindConnectionByARN               = findConnectionByARN
+	FindHostByARN                     = findHostByARN
+	FindRepositoryLinkByID            = findRepositoryLinkByID
+	FindSyncConfigurationByTwoPartKey = findSyncConfigurationByTwoPartKey
+)
@@ -0,0 +1,476 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeconnections
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codeconnections"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/codeconnections/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_codeconnections_host", name="Host")
+// @Tags(identifierAttribute="arn")
+func newHostResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &hostResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type hostResource struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+	framework.WithImportByID
+}
+
+func (*hostResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_codeconnections_host"
+}
+
+func (r *hostResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrID:  framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"provider_endpoint": schema.StringAttribute{
+				Required: true,
+			},
+			"provider_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ProviderType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+			names.AttrVPCConfiguration: hostVPCConfigurationBlock(ctx),
+		},
+	}
+}
+
+func (r *hostResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			SourceSchema: legacyHostResourceSchemaV0(ctx),
+			StateMover:   r.moveStateResourceHost,
+		},
+	}
+}
+
+func (r *hostResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data hostResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CodeConnectionsClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &codeconnections.CreateHostInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateHost(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating CodeConnections Host (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	arn := aws.ToString(output.HostArn)
+	data.ARN = types.StringValue(arn)
+	data.ID = types.StringValue(arn)
+
+	host, err := waitHostPendingOrAvailable(ctx, conn, arn, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for CodeConnections Host (%s) create", arn), err.Error())
+
+		return
+	}
+
+	data.Status = fwflex.StringToFramework(ctx, host.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *hostResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data hostResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CodeConnectionsClient(ctx)
+
+	output, err := findHostByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CodeConnections Host (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ARN = data.ID
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *hostResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old hostResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CodeConnectionsClient(ctx)
+
+	if !new.ProviderEndpoint.Equal(old.ProviderEndpoint) || !new.VPCConfiguration.Equal(old.VPCConfiguration) {
+		input := &codeconnections.UpdateHostInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.HostArn = new.ID.ValueStringPointer()
+
+		_, err := conn.UpdateHost(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating CodeConnections Host (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		host, err := waitHostPendingOrAvailable(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for CodeConnections Host (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.Status = fwflex.StringToFramework(ctx, host.Status)
+	} else {
+		new.Status = old.Status
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *hostResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data hostResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CodeConnectionsClient(ctx)
+
+	_, err := conn.DeleteHost(ctx, &codeconnections.DeleteHostInput{
+		HostArn: data.ID.ValueStringPointer(),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting CodeConnections Host (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *hostResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+// moveStateResourceHost transforms the state of an `aws_codestarconnections_host` resource to this resource's schema.
+func (r *hostResource) moveStateResourceHost(ctx context.Context, request resource.MoveStateRequest, response *resource.MoveStateResponse) {
+	if request.SourceTypeName != "aws_codestarconnections_host" {
+		return
+	}
+
+	if request.SourceSchemaVersion != 0 {
+		return
+	}
+
+	if !strings.HasSuffix(request.SourceProviderAddress, "hashicorp/aws") {
+		return
+	}
+
+	var source legacyHostResourceModel
+	response.Diagnostics.Append(request.SourceState.Get(ctx, &source)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The CodeStar Connections resource stores an empty string for an unconfigured TLS certificate.
+	vpcConfiguration, diags := source.VPCConfiguration.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	for _, v := range vpcConfiguration {
+		v.TLSCertificate = fwflex.EmptyStringAsNull(v.TLSCertificate)
+	}
+	if len(vpcConfiguration) > 0 {
+		source.VPCConfiguration = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, vpcConfiguration)
+	}
+
+	// Host ARNs are interchangeable between the CodeStar Connections and CodeConnections APIs.
+	target := &hostResourceModel{
+		ARN:              source.ARN,
+		ID:               source.ID,
+		Name:             source.Name,
+		ProviderEndpoint: source.ProviderEndpoint,
+		ProviderType:     source.ProviderType,
+		Status:           source.Status,
+		Tags:             tftags.Null,
+		TagsAll:          tftags.Null,
+		Timeouts:         source.Timeouts,
+		VPCConfiguration: source.VPCConfiguration,
+	}
+
+	response.Diagnostics.Append(response.TargetState.Set(ctx, target)...)
+}
+
+func findHostByARN(ctx context.Context, conn *codeconnections.Client, arn string) (*codeconnections.GetHostOutput, error) {
+	input := &codeconnections.GetHostInput{
+		HostArn: aws.String(arn),
+	}
+
+	output, err := conn.GetHost(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusHost(ctx context.Context, conn *codeconnections.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findHostByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.ToString(output.Status), nil
+	}
+}
+
+const (
+	hostStatusAvailable             = "AVAILABLE"
+	hostStatusPending               = "PENDING"
+	hostStatusVPCConfigInitializing = "VPC_CONFIG_INITIALIZING"
+)
+
+func waitHostPendingOrAvailable(ctx context.Context, conn *codeconnections.Client, arn string, timeout time.Duration) (*codeconnections.GetHostOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{hostStatusVPCConfigInitializing},
+		Target:  []string{hostStatusAvailable, hostStatusPending},
+		Refresh: statusHost(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*codeconnections.GetHostOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func hostVPCConfigurationBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[hostVPCConfigurationModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrSecurityGroupIDs: schema.SetAttribute{
+					CustomType:  fwtypes.SetOfStringType,
+					ElementType: types.StringType,
+					Required:    true,
+					Validators: []validator.Set{
+						setvalidator.SizeAtLeast(1),
+					},
+				},
+				names.AttrSubnetIDs: schema.SetAttribute{
+					CustomType:  fwtypes.SetOfStringType,
+					ElementType: types.StringType,
+					Required:    true,
+					Validators: []validator.Set{
+						setvalidator.SizeAtLeast(1),
+					},
+				},
+				"tls_certificate": schema.StringAttribute{
+					Optional: true,
+				},
+				names.AttrVPCID: schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+type hostResourceModel struct {
+	ARN              types.String                                               `tfsdk:"arn"`
+	ID               types.String                                               `tfsdk:"id"`
+	Name             types.String                                               `tfsdk:"name"`
+	ProviderEndpoint types.String                                               `tfsdk:"provider_endpoint"`
+	ProviderType     fwtypes.StringEnum[awstypes.ProviderType]                  `tfsdk:"provider_type"`
+	Status           types.String                                               `tfsdk:"status"`
+	Tags             tftags.Map                                                 `tfsdk:"tags"`
+	TagsAll          tftags.Map                                                 `tfsdk:"tags_all"`
+	Timeouts         timeouts.Value                                             `tfsdk:"timeouts"`
+	VPCConfiguration fwtypes.ListNestedObjectValueOf[hostVPCConfigurationModel] `tfsdk:"vpc_configuration"`
+}
+
+type hostVPCConfigurationModel struct {
+	SecurityGroupIDs fwtypes.SetValueOf[types.String] `tfsdk:"security_group_ids"`
+	SubnetIDs        fwtypes.SetValueOf[types.String] `tfsdk:"subnet_ids"`
+	TLSCertificate   types.String                     `tfsdk:"tls_certificate"`
+	VPCID            types.String                     `tfsdk:"vpc_id"`
+}
+
+// legacyHostResourceSchemaV0 returns version 0 of the schema for the `aws_codestarconnections_host` resource.
+func legacyHostResourceSchemaV0(ctx context.Context) *schema.Schema {
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			"provider_endpoint": schema.StringAttribute{
+				Required: true,
+			},
+			"provider_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ProviderType](),
+				Required:   true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+			names.AttrVPCConfiguration: hostVPCConfigurationBlock(ctx),
+		},
+		Version: 0,
+	}
+}
+
+type legacyHostResourceModel struct {
+	ARN              types.String                                               `tfsdk:"arn"`
+	ID               types.String                                               `tfsdk:"id"`
+	Name             types.String                                               `tfsdk:"name"`
+	ProviderEndpoint types.String                                               `tfsdk:"provider_endpoint"`
+	ProviderType     fwtypes.StringEnum[awstypes.ProviderType]                  `tfsdk:"provider_type"`
+	Status           types.String                                               `tfsdk:"status"`
+	Timeouts         timeouts.Value                                             `tfsdk:"timeouts"`
+	VPCConfiguration fwtypes.ListNestedObjectValueOf[hostVPCConfigurationModel] `tfsdk:"vpc_configuration"`
+}
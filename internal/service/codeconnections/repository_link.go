@@ -0,0 +1,262 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeconnections
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codeconnections"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/codeconnections/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// Sync configurations that use a repository link can take a short time to be released after deletion.
+	repositoryLinkDeleteTimeout = 2 * time.Minute
+)
+
+// @FrameworkResource("aws_codeconnections_repository_link", name="Repository Link")
+// @Tags(identifierAttribute="arn")
+func newRepositoryLinkResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &repositoryLinkResource{}, nil
+}
+
+type repositoryLinkResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*repositoryLinkResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_codeconnections_repository_link"
+}
+
+func (r *repositoryLinkResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"connection_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"encryption_key_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrOwnerID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"provider_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ProviderType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"repository_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (r *repositoryLinkResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data repositoryLinkResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CodeConnectionsClient(ctx)
+
+	name := data.RepositoryName.ValueString()
+	input := &codeconnections.CreateRepositoryLinkInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input, fwflex.WithFieldNamePrefix("RepositoryLink"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateRepositoryLink(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating CodeConnections Repository Link (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.RepositoryLinkInfo, &data, fwflex.WithFieldNamePrefix("RepositoryLink"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *repositoryLinkResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data repositoryLinkResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CodeConnectionsClient(ctx)
+
+	output, err := findRepositoryLinkByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CodeConnections Repository Link (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithFieldNamePrefix("RepositoryLink"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *repositoryLinkResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old repositoryLinkResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CodeConnectionsClient(ctx)
+
+	if !new.ConnectionARN.Equal(old.ConnectionARN) || !new.EncryptionKeyARN.Equal(old.EncryptionKeyARN) {
+		input := &codeconnections.UpdateRepositoryLinkInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input, fwflex.WithFieldNamePrefix("RepositoryLink"))...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		output, err := conn.UpdateRepositoryLink(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating CodeConnections Repository Link (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output.RepositoryLinkInfo, &new, fwflex.WithFieldNamePrefix("RepositoryLink"))...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *repositoryLinkResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data repositoryLinkResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CodeConnectionsClient(ctx)
+
+	_, err := tfresource.RetryWhenIsA[*awstypes.SyncConfigurationStillExistsException](ctx, repositoryLinkDeleteTimeout, func() (interface{}, error) {
+		return conn.DeleteRepositoryLink(ctx, &codeconnections.DeleteRepositoryLinkInput{
+			RepositoryLinkId: data.ID.ValueStringPointer(),
+		})
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting CodeConnections Repository Link (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *repositoryLinkResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findRepositoryLinkByID(ctx context.Context, conn *codeconnections.Client, id string) (*awstypes.RepositoryLinkInfo, error) {
+	input := &codeconnections.GetRepositoryLinkInput{
+		RepositoryLinkId: aws.String(id),
+	}
+
+	output, err := conn.GetRepositoryLink(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.RepositoryLinkInfo == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.RepositoryLinkInfo, nil
+}
+
+type repositoryLinkResourceModel struct {
+	ARN              types.String                              `tfsdk:"arn"`
+	ConnectionARN    fwtypes.ARN                               `tfsdk:"connection_arn"`
+	EncryptionKeyARN fwtypes.ARN                               `tfsdk:"encryption_key_arn"`
+	ID               types.String                              `tfsdk:"id"`
+	OwnerID          types.String                              `tfsdk:"owner_id"`
+	ProviderType     fwtypes.StringEnum[awstypes.ProviderType] `tfsdk:"provider_type"`
+	RepositoryName   types.String                              `tfsdk:"repository_name"`
+	Tags             tftags.Map                                `tfsdk:"tags"`
+	TagsAll          tftags.Map                                `tfsdk:"tags_all"`
+}
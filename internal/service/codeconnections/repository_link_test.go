@@ -0,0 +1,298 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeconnections_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/codeconnections/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcodeconnections "github.com/hashicorp/terraform-provider-aws/internal/service/codeconnections"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Repository links require a connection that has been authorized with the source provider.
+// Such a connection can only be completed in the console, so it must be supplied out-of-band.
+const (
+	envVarConnectionARN   = "AWS_CODECONNECTIONS_CONNECTION_ARN"
+	envVarRepositoryName  = "AWS_CODECONNECTIONS_REPOSITORY_NAME"
+	envVarRepositoryOwner = "AWS_CODECONNECTIONS_REPOSITORY_OWNER"
+)
+
+func TestAccCodeConnectionsRepositoryLink_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	connectionARN := acctest.SkipIfEnvVarNotSet(t, envVarConnectionARN)
+	repositoryName := acctest.SkipIfEnvVarNotSet(t, envVarRepositoryName)
+	repositoryOwner := acctest.SkipIfEnvVarNotSet(t, envVarRepositoryOwner)
+	var v awstypes.RepositoryLinkInfo
+	resourceName := "aws_codeconnections_repository_link.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeConnectionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRepositoryLinkDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepositoryLinkConfig_basic(connectionARN, repositoryOwner, repositoryName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRepositoryLinkExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "codeconnections", regexache.MustCompile("repository-link/.+")),
+					resource.TestCheckResourceAttr(resourceName, "connection_arn", connectionARN),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrOwnerID, repositoryOwner),
+					resource.TestCheckResourceAttrSet(resourceName, "provider_type"),
+					resource.TestCheckResourceAttr(resourceName, "repository_name", repositoryName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCodeConnectionsRepositoryLink_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	connectionARN := acctest.SkipIfEnvVarNotSet(t, envVarConnectionARN)
+	repositoryName := acctest.SkipIfEnvVarNotSet(t, envVarRepositoryName)
+	repositoryOwner := acctest.SkipIfEnvVarNotSet(t, envVarRepositoryOwner)
+	var v awstypes.RepositoryLinkInfo
+	resourceName := "aws_codeconnections_repository_link.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeConnectionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRepositoryLinkDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepositoryLinkConfig_basic(connectionARN, repositoryOwner, repositoryName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRepositoryLinkExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfcodeconnections.ResourceRepositoryLink, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCodeConnectionsRepositoryLink_encryptionKeyARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	connectionARN := acctest.SkipIfEnvVarNotSet(t, envVarConnectionARN)
+	repositoryName := acctest.SkipIfEnvVarNotSet(t, envVarRepositoryName)
+	repositoryOwner := acctest.SkipIfEnvVarNotSet(t, envVarRepositoryOwner)
+	var v awstypes.RepositoryLinkInfo
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeconnections_repository_link.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeConnectionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRepositoryLinkDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepositoryLinkConfig_encryptionKeyARN(rName, connectionARN, repositoryOwner, repositoryName, "test1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRepositoryLinkExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "encryption_key_arn", "aws_kms_key.test1", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRepositoryLinkConfig_encryptionKeyARN(rName, connectionARN, repositoryOwner, repositoryName, "test2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRepositoryLinkExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "encryption_key_arn", "aws_kms_key.test2", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCodeConnectionsRepositoryLink_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	connectionARN := acctest.SkipIfEnvVarNotSet(t, envVarConnectionARN)
+	repositoryName := acctest.SkipIfEnvVarNotSet(t, envVarRepositoryName)
+	repositoryOwner := acctest.SkipIfEnvVarNotSet(t, envVarRepositoryOwner)
+	var v awstypes.RepositoryLinkInfo
+	resourceName := "aws_codeconnections_repository_link.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeConnectionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRepositoryLinkDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepositoryLinkConfig_tags1(connectionARN, repositoryOwner, repositoryName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRepositoryLinkExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRepositoryLinkConfig_tags2(connectionARN, repositoryOwner, repositoryName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRepositoryLinkExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccRepositoryLinkConfig_tags1(connectionARN, repositoryOwner, repositoryName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRepositoryLinkExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRepositoryLinkExists(ctx context.Context, n string, v *awstypes.RepositoryLinkInfo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeConnectionsClient(ctx)
+
+		output, err := tfcodeconnections.FindRepositoryLinkByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckRepositoryLinkDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeConnectionsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_codeconnections_repository_link" {
+				continue
+			}
+
+			_, err := tfcodeconnections.FindRepositoryLinkByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CodeConnections Repository Link %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccRepositoryLinkConfig_basic(connectionARN, repositoryOwner, repositoryName string) string {
+	return fmt.Sprintf(`
+resource "aws_codeconnections_repository_link" "test" {
+  connection_arn  = %[1]q
+  owner_id        = %[2]q
+  repository_name = %[3]q
+}
+`, connectionARN, repositoryOwner, repositoryName)
+}
+
+func testAccRepositoryLinkConfig_encryptionKeyARN(rName, connectionARN, repositoryOwner, repositoryName, keyName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test1" {
+  description             = "%[1]s-1"
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+resource "aws_kms_key" "test2" {
+  description             = "%[1]s-2"
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+resource "aws_codeconnections_repository_link" "test" {
+  connection_arn     = %[2]q
+  encryption_key_arn = aws_kms_key.%[5]s.arn
+  owner_id           = %[3]q
+  repository_name    = %[4]q
+}
+`, rName, connectionARN, repositoryOwner, repositoryName, keyName)
+}
+
+func testAccRepositoryLinkConfig_tags1(connectionARN, repositoryOwner, repositoryName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_codeconnections_repository_link" "test" {
+  connection_arn  = %[1]q
+  owner_id        = %[2]q
+  repository_name = %[3]q
+
+  tags = {
+    %[4]q = %[5]q
+  }
+}
+`, connectionARN, repositoryOwner, repositoryName, tagKey1, tagValue1)
+}
+
+func testAccRepositoryLinkConfig_tags2(connectionARN, repositoryOwner, repositoryName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_codeconnections_repository_link" "test" {
+  connection_arn  = %[1]q
+  owner_id        = %[2]q
+  repository_name = %[3]q
+
+  tags = {
+    %[4]q = %[5]q
+    %[6]q = %[7]q
+  }
+}
+`, connectionARN, repositoryOwner, repositoryName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeconnections
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codeconnections"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/codeconnections/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_codeconnections_connection", sweepConnections, "aws_codeconnections_repository_link")
+	awsv2.Register("aws_codeconnections_host", sweepHosts, "aws_codeconnections_connection")
+	awsv2.Register("aws_codeconnections_repository_link", sweepRepositoryLinks, "aws_codeconnections_sync_configuration")
+	awsv2.Register("aws_codeconnections_sync_configuration", sweepSyncConfigurations)
+}
+
+func sweepConnections(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.CodeConnectionsClient(ctx)
+	input := &codeconnections.ListConnectionsInput{}
+	var sweepResources []sweep.Sweepable
+
+	pages := codeconnections.NewListConnectionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Connections {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newConnectionResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.ConnectionArn))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepHosts(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.CodeConnectionsClient(ctx)
+	input := &codeconnections.ListHostsInput{}
+	var sweepResources []sweep.Sweepable
+
+	pages := codeconnections.NewListHostsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Hosts {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newHostResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.HostArn))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepRepositoryLinks(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.CodeConnectionsClient(ctx)
+	var sweepResources []sweep.Sweepable
+
+	err := listRepositoryLinkIDs(ctx, conn, func(repositoryLinkID string) error {
+		sweepResources = append(sweepResources, framework.NewSweepResource(newRepositoryLinkResource, client,
+			framework.NewAttribute(names.AttrID, repositoryLinkID)))
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepSyncConfigurations(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.CodeConnectionsClient(ctx)
+	var sweepResources []sweep.Sweepable
+
+	err := listRepositoryLinkIDs(ctx, conn, func(repositoryLinkID string) error {
+		for _, syncType := range awstypes.SyncConfigurationType("").Values() {
+			input := &codeconnections.ListSyncConfigurationsInput{
+				RepositoryLinkId: aws.String(repositoryLinkID),
+				SyncType:         syncType,
+			}
+
+			pages := codeconnections.NewListSyncConfigurationsPaginator(conn, input)
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+
+				if err != nil {
+					return err
+				}
+
+				for _, v := range page.SyncConfigurations {
+					sweepResources = append(sweepResources, framework.NewSweepResource(newSyncConfigurationResource, client,
+						framework.NewAttribute("resource_name", aws.ToString(v.ResourceName)),
+						framework.NewAttribute("sync_type", string(v.SyncType))))
+				}
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func listRepositoryLinkIDs(ctx context.Context, conn *codeconnections.Client, f func(string) error) error {
+	input := &codeconnections.ListRepositoryLinksInput{}
+
+	pages := codeconnections.NewListRepositoryLinksPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range page.RepositoryLinks {
+			if err := f(aws.ToString(v.RepositoryLinkId)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
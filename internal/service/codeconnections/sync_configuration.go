@@ -0,0 +1,319 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeconnections
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codeconnections"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/codeconnections/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_codeconnections_sync_configuration", name="Sync Configuration")
+func newSyncConfigurationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &syncConfigurationResource{}, nil
+}
+
+type syncConfigurationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*syncConfigurationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_codeconnections_sync_configuration"
+}
+
+func (r *syncConfigurationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"branch": schema.StringAttribute{
+				Required: true,
+			},
+			"config_file": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrOwnerID: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"provider_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ProviderType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"publish_deployment_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PublishDeploymentStatus](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pull_request_comment": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PullRequestComment](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"repository_link_id": schema.StringAttribute{
+				Required: true,
+			},
+			"repository_name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resource_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"sync_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.SyncConfigurationType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"trigger_resource_update_on": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TriggerResourceUpdateOn](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *syncConfigurationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data syncConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CodeConnectionsClient(ctx)
+
+	name := data.ResourceName.ValueString()
+	input := &codeconnections.CreateSyncConfigurationInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateSyncConfiguration(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating CodeConnections Sync Configuration (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.SyncConfiguration, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := data.setID()
+	if err != nil {
+		response.Diagnostics.AddError("flattening resource ID CodeConnections Sync Configuration", err.Error())
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *syncConfigurationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data syncConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().CodeConnectionsClient(ctx)
+
+	output, err := findSyncConfigurationByTwoPartKey(ctx, conn, data.ResourceName.ValueString(), data.SyncType.ValueEnum())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CodeConnections Sync Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *syncConfigurationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new syncConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CodeConnectionsClient(ctx)
+
+	input := &codeconnections.UpdateSyncConfigurationInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.UpdateSyncConfiguration(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating CodeConnections Sync Configuration (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.SyncConfiguration, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *syncConfigurationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data syncConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CodeConnectionsClient(ctx)
+
+	_, err := conn.DeleteSyncConfiguration(ctx, &codeconnections.DeleteSyncConfigurationInput{
+		ResourceName: data.ResourceName.ValueStringPointer(),
+		SyncType:     data.SyncType.ValueEnum(),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting CodeConnections Sync Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findSyncConfigurationByTwoPartKey(ctx context.Context, conn *codeconnections.Client, resourceName string, syncType awstypes.SyncConfigurationType) (*awstypes.SyncConfiguration, error) {
+	input := &codeconnections.GetSyncConfigurationInput{
+		ResourceName: aws.String(resourceName),
+		SyncType:     syncType,
+	}
+
+	output, err := conn.GetSyncConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.SyncConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.SyncConfiguration, nil
+}
+
+type syncConfigurationResourceModel struct {
+	Branch                  types.String                                         `tfsdk:"branch"`
+	ConfigFile              types.String                                         `tfsdk:"config_file"`
+	ID                      types.String                                         `tfsdk:"id"`
+	OwnerID                 types.String                                         `tfsdk:"owner_id"`
+	ProviderType            fwtypes.StringEnum[awstypes.ProviderType]            `tfsdk:"provider_type"`
+	PublishDeploymentStatus fwtypes.StringEnum[awstypes.PublishDeploymentStatus] `tfsdk:"publish_deployment_status"`
+	PullRequestComment      fwtypes.StringEnum[awstypes.PullRequestComment]      `tfsdk:"pull_request_comment"`
+	RepositoryLinkID        types.String                                         `tfsdk:"repository_link_id"`
+	RepositoryName          types.String                                         `tfsdk:"repository_name"`
+	ResourceName            types.String                                         `tfsdk:"resource_name"`
+	RoleARN                 fwtypes.ARN                                          `tfsdk:"role_arn"`
+	SyncType                fwtypes.StringEnum[awstypes.SyncConfigurationType]   `tfsdk:"sync_type"`
+	TriggerResourceUpdateOn fwtypes.StringEnum[awstypes.TriggerResourceUpdateOn] `tfsdk:"trigger_resource_update_on"`
+}
+
+const (
+	syncConfigurationResourceIDPartCount = 2
+)
+
+func (m *syncConfigurationResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(m.ID.ValueString(), syncConfigurationResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	m.ResourceName = types.StringValue(parts[0])
+	m.SyncType = fwtypes.StringEnumValue(awstypes.SyncConfigurationType(parts[1]))
+
+	return nil
+}
+
+func (m *syncConfigurationResourceModel) setID() (string, error) {
+	parts := []string{
+		m.ResourceName.ValueString(),
+		string(m.SyncType.ValueEnum()),
+	}
+
+	return flex.FlattenResourceId(parts, syncConfigurationResourceIDPartCount, false)
+}
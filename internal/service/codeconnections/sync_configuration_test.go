@@ -0,0 +1,243 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeconnections_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/codeconnections/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcodeconnections "github.com/hashicorp/terraform-provider-aws/internal/service/codeconnections"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCodeConnectionsSyncConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	connectionARN := acctest.SkipIfEnvVarNotSet(t, envVarConnectionARN)
+	repositoryName := acctest.SkipIfEnvVarNotSet(t, envVarRepositoryName)
+	repositoryOwner := acctest.SkipIfEnvVarNotSet(t, envVarRepositoryOwner)
+	var v awstypes.SyncConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeconnections_sync_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeConnectionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSyncConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSyncConfigurationConfig_basic(rName, connectionARN, repositoryOwner, repositoryName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSyncConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "branch", "main"),
+					resource.TestCheckResourceAttr(resourceName, "config_file", "deployment-file.yaml"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrOwnerID, repositoryOwner),
+					resource.TestCheckResourceAttrSet(resourceName, "provider_type"),
+					resource.TestCheckResourceAttrSet(resourceName, "publish_deployment_status"),
+					resource.TestCheckResourceAttrSet(resourceName, "pull_request_comment"),
+					resource.TestCheckResourceAttrPair(resourceName, "repository_link_id", "aws_codeconnections_repository_link.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "repository_name", repositoryName),
+					resource.TestCheckResourceAttr(resourceName, "resource_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "sync_type", string(awstypes.SyncConfigurationTypeCfnStackSync)),
+					resource.TestCheckResourceAttrSet(resourceName, "trigger_resource_update_on"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCodeConnectionsSyncConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	connectionARN := acctest.SkipIfEnvVarNotSet(t, envVarConnectionARN)
+	repositoryName := acctest.SkipIfEnvVarNotSet(t, envVarRepositoryName)
+	repositoryOwner := acctest.SkipIfEnvVarNotSet(t, envVarRepositoryOwner)
+	var v awstypes.SyncConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeconnections_sync_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeConnectionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSyncConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSyncConfigurationConfig_basic(rName, connectionARN, repositoryOwner, repositoryName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSyncConfigurationExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfcodeconnections.ResourceSyncConfiguration, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCodeConnectionsSyncConfiguration_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	connectionARN := acctest.SkipIfEnvVarNotSet(t, envVarConnectionARN)
+	repositoryName := acctest.SkipIfEnvVarNotSet(t, envVarRepositoryName)
+	repositoryOwner := acctest.SkipIfEnvVarNotSet(t, envVarRepositoryOwner)
+	var v awstypes.SyncConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeconnections_sync_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeConnectionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSyncConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSyncConfigurationConfig_full(rName, connectionARN, repositoryOwner, repositoryName, "ENABLED", "ENABLED", "ANY_CHANGE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSyncConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "publish_deployment_status", string(awstypes.PublishDeploymentStatusEnabled)),
+					resource.TestCheckResourceAttr(resourceName, "pull_request_comment", string(awstypes.PullRequestCommentEnabled)),
+					resource.TestCheckResourceAttr(resourceName, "trigger_resource_update_on", string(awstypes.TriggerResourceUpdateOnAnyChange)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSyncConfigurationConfig_full(rName, connectionARN, repositoryOwner, repositoryName, "DISABLED", "DISABLED", "FILE_CHANGE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSyncConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "publish_deployment_status", string(awstypes.PublishDeploymentStatusDisabled)),
+					resource.TestCheckResourceAttr(resourceName, "pull_request_comment", string(awstypes.PullRequestCommentDisabled)),
+					resource.TestCheckResourceAttr(resourceName, "trigger_resource_update_on", string(awstypes.TriggerResourceUpdateOnFileChange)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSyncConfigurationExists(ctx context.Context, n string, v *awstypes.SyncConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeConnectionsClient(ctx)
+
+		output, err := tfcodeconnections.FindSyncConfigurationByTwoPartKey(ctx, conn, rs.Primary.Attributes["resource_name"], awstypes.SyncConfigurationType(rs.Primary.Attributes["sync_type"]))
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckSyncConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeConnectionsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_codeconnections_sync_configuration" {
+				continue
+			}
+
+			_, err := tfcodeconnections.FindSyncConfigurationByTwoPartKey(ctx, conn, rs.Primary.Attributes["resource_name"], awstypes.SyncConfigurationType(rs.Primary.Attributes["sync_type"]))
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CodeConnections Sync Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccSyncConfigurationConfig_base(rName, connectionARN, repositoryOwner, repositoryName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "cloudformation.sync.codeconnections.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_codeconnections_repository_link" "test" {
+  connection_arn  = %[2]q
+  owner_id        = %[3]q
+  repository_name = %[4]q
+}
+`, rName, connectionARN, repositoryOwner, repositoryName)
+}
+
+func testAccSyncConfigurationConfig_basic(rName, connectionARN, repositoryOwner, repositoryName string) string {
+	return acctest.ConfigCompose(testAccSyncConfigurationConfig_base(rName, connectionARN, repositoryOwner, repositoryName), fmt.Sprintf(`
+resource "aws_codeconnections_sync_configuration" "test" {
+  branch             = "main"
+  config_file        = "deployment-file.yaml"
+  repository_link_id = aws_codeconnections_repository_link.test.id
+  resource_name      = %[1]q
+  role_arn           = aws_iam_role.test.arn
+  sync_type          = "CFN_STACK_SYNC"
+}
+`, rName))
+}
+
+func testAccSyncConfigurationConfig_full(rName, connectionARN, repositoryOwner, repositoryName, publishDeploymentStatus, pullRequestComment, triggerResourceUpdateOn string) string {
+	return acctest.ConfigCompose(testAccSyncConfigurationConfig_base(rName, connectionARN, repositoryOwner, repositoryName), fmt.Sprintf(`
+resource "aws_codeconnections_sync_configuration" "test" {
+  branch                     = "main"
+  config_file                = "deployment-file.yaml"
+  publish_deployment_status  = %[2]q
+  pull_request_comment       = %[3]q
+  repository_link_id         = aws_codeconnections_repository_link.test.id
+  resource_name              = %[1]q
+  role_arn                   = aws_iam_role.test.arn
+  sync_type                  = "CFN_STACK_SYNC"
+  trigger_resource_update_on = %[4]q
+}
+`, rName, publishDeploymentStatus, pullRequestComment, triggerResourceUpdateOn))
+}
@@ -29,6 +29,10 @@ func newEBSFastSnapshotRestoreResource(_ context.Context) (resource.ResourceWith
 
 	r.SetDefaultCreateTimeout(10 * time.Minute)
 	r.SetDefaultDeleteTimeout(10 * time.Minute)
+	r.SetIdentity(
+		framework.IdentityAttribute{Name: names.AttrAvailabilityZone},
+		framework.IdentityAttribute{Name: names.AttrSnapshotID},
+	)
 
 	return r, nil
 }
@@ -36,7 +40,7 @@ func newEBSFastSnapshotRestoreResource(_ context.Context) (resource.ResourceWith
 type ebsFastSnapshotRestoreResource struct {
 	framework.ResourceWithConfigure
 	framework.WithNoUpdate
-	framework.WithImportByID
+	framework.WithImportByIdentity
 	framework.WithTimeouts
 }
 
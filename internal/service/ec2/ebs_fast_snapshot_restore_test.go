@@ -45,6 +45,12 @@ func TestAccEC2EBSFastSnapshotRestore_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccEBSFastSnapshotRestoreImportStateIDFunc(resourceName),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -143,6 +149,17 @@ func testAccCheckEBSFastSnapshotRestoreExists(ctx context.Context, n string) res
 	}
 }
 
+func testAccEBSFastSnapshotRestoreImportStateIDFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return fmt.Sprintf(`{"availability_zone":%q,"snapshot_id":%q}`, rs.Primary.Attributes[names.AttrAvailabilityZone], rs.Primary.Attributes[names.AttrSnapshotID]), nil
+	}
+}
+
 func testAccEBSFastSnapshotRestoreConfig_base(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
 resource "aws_ebs_volume" "test" {
@@ -18,6 +18,8 @@ import (
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
@@ -1326,6 +1328,10 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	setTagsOut(ctx, instance.Tags)
 
+	if err := setInstanceAutoScalingGroupTagsPropagated(ctx, meta.(*conns.AWSClient).AutoScalingClient(ctx), instance.Tags); err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Instance (%s) Auto Scaling group tags: %s", d.Id(), err)
+	}
+
 	if _, ok := d.GetOk("volume_tags"); ok && !blockDeviceTagsDefined(d) {
 		volumeTags, err := readVolumeTags(ctx, conn, d.Id())
 		if err != nil {
@@ -3835,6 +3841,52 @@ func expandLaunchTemplateSpecification(tfMap map[string]interface{}) *awstypes.L
 	return apiObject
 }
 
+// setInstanceAutoScalingGroupTagsPropagated adds the tags that the Auto Scaling group which launched an instance propagates at launch
+// to the tags propagated to the instance in Context.
+// The group's tags are only read if the provider is configured to ignore propagated tags.
+func setInstanceAutoScalingGroupTagsPropagated(ctx context.Context, conn *autoscaling.Client, tags []awstypes.Tag) error {
+	if inContext, ok := tftags.FromContext(ctx); !ok || !inContext.IgnorePropagated() {
+		return nil
+	}
+
+	groupName := keyValueTags(ctx, tags).KeyValue("aws:autoscaling:groupName")
+	if groupName == nil {
+		return nil
+	}
+
+	input := &autoscaling.DescribeTagsInput{
+		Filters: []autoscalingtypes.Filter{
+			{
+				Name:   aws.String("auto-scaling-group"),
+				Values: []string{aws.ToString(groupName)},
+			},
+		},
+	}
+	var propagated []awstypes.Tag
+
+	pages := autoscaling.NewDescribeTagsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range page.Tags {
+			if aws.ToBool(v.PropagateAtLaunch) {
+				propagated = append(propagated, awstypes.Tag{
+					Key:   v.Key,
+					Value: v.Value,
+				})
+			}
+		}
+	}
+
+	setTagsPropagated(ctx, propagated)
+
+	return nil
+}
+
 func flattenInstanceLaunchTemplate(ctx context.Context, conn *ec2.Client, instanceID, previousLaunchTemplateVersion string) ([]interface{}, error) {
 	launchTemplateID, err := findInstanceLaunchTemplateID(ctx, conn, instanceID)
 
@@ -3867,7 +3919,7 @@ func flattenInstanceLaunchTemplate(ctx context.Context, conn *ec2.Client, instan
 		return nil, err
 	}
 
-	_, err = findLaunchTemplateVersionByTwoPartKey(ctx, conn, launchTemplateID, currentLaunchTemplateVersion)
+	launchTemplateVersion, err := findLaunchTemplateVersionByTwoPartKey(ctx, conn, launchTemplateID, currentLaunchTemplateVersion)
 
 	if tfresource.NotFound(err) {
 		return []interface{}{tfMap}, nil
@@ -3877,6 +3929,11 @@ func flattenInstanceLaunchTemplate(ctx context.Context, conn *ec2.Client, instan
 		return nil, fmt.Errorf("reading EC2 Launch Template (%s) version (%s): %w", launchTemplateID, currentLaunchTemplateVersion, err)
 	}
 
+	// The launch template's instance tag specifications are propagated to the instance.
+	if v := launchTemplateVersion.LaunchTemplateData; v != nil {
+		setTagsPropagated(ctx, tagsFromLaunchTemplateTagSpecifications(v.TagSpecifications, awstypes.ResourceTypeInstance))
+	}
+
 	switch previousLaunchTemplateVersion {
 	case launchTemplateVersionDefault:
 		if currentLaunchTemplateVersion == defaultVersion {
@@ -99,6 +99,26 @@ func tagsFromTagDescriptions(tds []awstypes.TagDescription) []awstypes.Tag {
 	return tags
 }
 
+// setTagsPropagated adds to the tags that AWS propagates to a resource from a parent resource in Context.
+func setTagsPropagated(ctx context.Context, tags []awstypes.Tag) {
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		inContext.AddTagsPropagated(keyValueTags(ctx, tags))
+	}
+}
+
+// tagsFromLaunchTemplateTagSpecifications returns the tags that a launch template's tag specifications apply to resources of the specified type.
+func tagsFromLaunchTemplateTagSpecifications(apiObjects []awstypes.LaunchTemplateTagSpecification, resourceType awstypes.ResourceType) []awstypes.Tag {
+	var tags []awstypes.Tag
+
+	for _, apiObject := range apiObjects {
+		if apiObject.ResourceType == resourceType {
+			tags = append(tags, apiObject.Tags...)
+		}
+	}
+
+	return tags
+}
+
 func tagsSchemaConflictsWith(conflictsWith []string) *schema.Schema {
 	v := *tftags.TagsSchema() // nosemgrep:ci.semgrep.aws.prefer-pointer-conversion-assignment
 	v.ConflictsWith = conflictsWith
@@ -53,6 +53,12 @@ func TestAccVPCSecurityGroupEgressRule_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccSecurityGroupRuleImportStateIDFunc(resourceName),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -47,6 +47,7 @@ import (
 func newSecurityGroupIngressRuleResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &securityGroupIngressRuleResource{}
 	r.securityGroupRule = r
+	r.SetIdentity(framework.IdentityAttribute{Name: "security_group_rule_id"})
 
 	return r, nil
 }
@@ -171,7 +172,7 @@ type securityGroupRule interface {
 type securityGroupRuleResource struct {
 	securityGroupRule
 	framework.ResourceWithConfigure
-	framework.WithImportByID
+	framework.WithImportByIdentity
 }
 
 func (r *securityGroupRuleResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource(name="Cluster Auth")
+func newEphemeralClusterAuth(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralClusterAuth{}, nil
+}
+
+type ephemeralClusterAuth struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (*ephemeralClusterAuth) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_eks_cluster_auth"
+}
+
+func (e *ephemeralClusterAuth) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralClusterAuth) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data ephemeralClusterAuthModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().STSClient(ctx)
+
+	name := data.Name.ValueString()
+	generator, err := NewGenerator(false, false)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating EKS Authentication Token generator", err.Error())
+
+		return
+	}
+
+	token, err := generator.GetWithSTS(ctx, name, conn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EKS Cluster (%s) Authentication Token", name), err.Error())
+
+		return
+	}
+
+	data.Token = types.StringValue(token.Token)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type ephemeralClusterAuthModel struct {
+	Name  types.String `tfsdk:"name"`
+	Token types.String `tfsdk:"token"`
+}
@@ -883,6 +883,27 @@ func findLoadBalancers(ctx context.Context, conn *elasticloadbalancingv2.Client,
 	return output, nil
 }
 
+// forEachLoadBalancer calls fn for each ELBv2 Load Balancer, stopping early if fn returns false.
+// It is shared by the ELBv2 Load Balancer sweeper and the aws_lb list resource.
+func forEachLoadBalancer(ctx context.Context, conn *elasticloadbalancingv2.Client, input *elasticloadbalancingv2.DescribeLoadBalancersInput, fn func(*awstypes.LoadBalancer) bool) error {
+	pages := elasticloadbalancingv2.NewDescribeLoadBalancersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range page.LoadBalancers {
+			if !fn(&v) {
+				return nil
+			}
+		}
+	}
+
+	return nil
+}
+
 func findLoadBalancerByARN(ctx context.Context, conn *elasticloadbalancingv2.Client, arn string) (*awstypes.LoadBalancer, error) {
 	input := &elasticloadbalancingv2.DescribeLoadBalancersInput{
 		LoadBalancerArns: []string{arn},
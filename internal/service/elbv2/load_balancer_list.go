@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elbv2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// @ListResource("aws_lb", name="Load Balancer")
+func listLoadBalancers(ctx context.Context, meta any, request types.ListResourceRequest, fn func(types.ListResult) bool) error {
+	conn := meta.(*conns.AWSClient).ELBV2Client(ctx)
+
+	var err error
+	input := &elasticloadbalancingv2.DescribeLoadBalancersInput{}
+	listErr := forEachLoadBalancer(ctx, conn, input, func(v *awstypes.LoadBalancer) bool {
+		arn := aws.ToString(v.LoadBalancerArn)
+		result := types.ListResult{
+			DisplayName: aws.ToString(v.LoadBalancerName),
+			ImportID:    arn,
+		}
+
+		if request.IncludeTags {
+			tags, tagsErr := listTags(ctx, conn, arn)
+
+			if tagsErr != nil {
+				err = fmt.Errorf("listing tags for ELBv2 Load Balancer (%s): %w", arn, tagsErr)
+				return false
+			}
+
+			result.Tags = tags.IgnoreAWS().Map()
+		}
+
+		return fn(result)
+	})
+
+	if listErr != nil {
+		return fmt.Errorf("listing ELBv2 Load Balancers: %w", listErr)
+	}
+
+	return err
+}
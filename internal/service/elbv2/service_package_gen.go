@@ -30,6 +30,16 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 	return []*types.ServicePackageFrameworkResource{}
 }
 
+func (p *servicePackage) ListResources(ctx context.Context) []*types.ServicePackageListResource {
+	return []*types.ServicePackageListResource{
+		{
+			List:     listLoadBalancers,
+			TypeName: "aws_lb",
+			Name:     "Load Balancer",
+		},
+	}
+}
+
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
 		{
@@ -10,6 +10,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
@@ -18,7 +19,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_lb", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lb", &resource.Sweeper{
 		Name: "aws_lb",
 		F:    sweepLoadBalancers,
 		Dependencies: []string{
@@ -28,7 +29,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_lb_target_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lb_target_group", &resource.Sweeper{
 		Name: "aws_lb_target_group",
 		F:    sweepTargetGroups,
 		Dependencies: []string{
@@ -36,7 +37,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_lb_listener", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lb_listener", &resource.Sweeper{
 		Name: "aws_lb_listener",
 		F:    sweepListeners,
 	})
@@ -54,26 +55,23 @@ func sweepLoadBalancers(region string) error {
 	conn := client.ELBV2Client(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	pages := elasticloadbalancingv2.NewDescribeLoadBalancersPaginator(conn, input)
-	for pages.HasMorePages() {
-		page, err := pages.NextPage(ctx)
+	err = forEachLoadBalancer(ctx, conn, input, func(v *awstypes.LoadBalancer) bool {
+		r := resourceLoadBalancer()
+		d := r.Data(nil)
+		d.SetId(aws.ToString(v.LoadBalancerArn))
 
-		if awsv2.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping ELBv2 Load Balancer sweep for %s: %s", region, err)
-			return nil
-		}
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 
-		if err != nil {
-			return fmt.Errorf("error listing ELBv2 Load Balancers (%s): %w", region, err)
-		}
+		return true
+	})
 
-		for _, v := range page.LoadBalancers {
-			r := resourceLoadBalancer()
-			d := r.Data(nil)
-			d.SetId(aws.ToString(v.LoadBalancerArn))
+	if awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping ELBv2 Load Balancer sweep for %s: %s", region, err)
+		return nil
+	}
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
-		}
+	if err != nil {
+		return fmt.Errorf("error listing ELBv2 Load Balancers (%s): %w", region, err)
 	}
 
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
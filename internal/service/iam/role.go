@@ -656,6 +656,26 @@ func findRole(ctx context.Context, conn *iam.Client, input *iam.GetRoleInput) (*
 	return output.Role, nil
 }
 
+// forEachRole calls fn for each IAM Role, stopping early if fn returns false.
+// It is shared by the IAM Role sweeper and the aws_iam_role list resource.
+func forEachRole(ctx context.Context, conn *iam.Client, fn func(*awstypes.Role) bool) error {
+	input := &iam.ListRolesInput{}
+
+	return listRolesPages(ctx, conn, input, func(page *iam.ListRolesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Roles {
+			if !fn(&v) {
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+}
+
 func findRoleAttachedPolicies(ctx context.Context, conn *iam.Client, roleName string) ([]string, error) {
 	input := &iam.ListAttachedRolePoliciesInput{
 		RoleName: aws.String(roleName),
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// @ListResource("aws_iam_role", name="Role")
+func listRoles(ctx context.Context, meta any, request types.ListResourceRequest, fn func(types.ListResult) bool) error {
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	var err error
+	listErr := forEachRole(ctx, conn, func(v *awstypes.Role) bool {
+		roleName := aws.ToString(v.RoleName)
+		result := types.ListResult{
+			DisplayName: roleName,
+			ImportID:    roleName,
+		}
+
+		if request.IncludeTags {
+			tags, tagsErr := roleTags(ctx, conn, roleName)
+
+			if tagsErr != nil {
+				err = fmt.Errorf("listing tags for IAM Role (%s): %w", roleName, tagsErr)
+				return false
+			}
+
+			result.Tags = KeyValueTags(ctx, tags).IgnoreAWS().Map()
+		}
+
+		return fn(result)
+	})
+
+	if listErr != nil {
+		return fmt.Errorf("listing IAM Roles: %w", listErr)
+	}
+
+	return err
+}
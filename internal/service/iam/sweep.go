@@ -29,7 +29,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_iam_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_group", &resource.Sweeper{
 		Name: "aws_iam_group",
 		F:    sweepGroups,
 		Dependencies: []string{
@@ -43,7 +43,7 @@ func RegisterSweepers() {
 
 	awsv2.Register("aws_iam_openid_connect_provider", sweepOpenIDConnectProvider)
 
-	resource.AddTestSweepers("aws_iam_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_policy", &resource.Sweeper{
 		Name: "aws_iam_policy",
 		F:    sweepPolicies,
 		Dependencies: []string{
@@ -55,7 +55,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iam_role", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_role", &resource.Sweeper{
 		Name: "aws_iam_role",
 		Dependencies: []string{
 			"aws_batch_compute_environment",
@@ -92,14 +92,14 @@ func RegisterSweepers() {
 
 	awsv2.Register("aws_iam_signing_certificate", sweepSigningCertificates)
 
-	resource.AddTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
 		Name: "aws_iam_server_certificate",
 		F:    sweepServerCertificates,
 	})
 
 	awsv2.Register("aws_iam_service_linked_role", sweepServiceLinkedRoles)
 
-	resource.AddTestSweepers("aws_iam_user", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_user", &resource.Sweeper{
 		Name: "aws_iam_user",
 		F:    sweepUsers,
 		Dependencies: []string{
@@ -429,26 +429,24 @@ func sweepRoles(region string) error {
 	conn := client.IAMClient(ctx)
 
 	roles := make([]string, 0)
-	pages := iam.NewListRolesPaginator(conn, &iam.ListRolesInput{})
-	for pages.HasMorePages() {
-		page, err := pages.NextPage(ctx)
-		if awsv2.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping IAM Role sweep for %s: %s", region, err)
-			return nil
+	err = forEachRole(ctx, conn, func(v *awstypes.Role) bool {
+		roleName := aws.ToString(v.RoleName)
+		if roleNameFilter(roleName) {
+			roles = append(roles, roleName)
+		} else {
+			log.Printf("[INFO] Skipping IAM Role (%s): no match on allow-list", roleName)
 		}
 
-		if err != nil {
-			return fmt.Errorf("retrieving IAM Roles: %w", err)
-		}
+		return true
+	})
 
-		for _, role := range page.Roles {
-			roleName := aws.ToString(role.RoleName)
-			if roleNameFilter(roleName) {
-				roles = append(roles, roleName)
-			} else {
-				log.Printf("[INFO] Skipping IAM Role (%s): no match on allow-list", roleName)
-			}
-		}
+	if awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IAM Role sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("retrieving IAM Roles: %w", err)
 	}
 
 	if len(roles) == 0 {
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource(name="Secrets")
+func newEphemeralSecrets(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralSecrets{}, nil
+}
+
+type ephemeralSecrets struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (*ephemeralSecrets) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_kms_secrets"
+}
+
+func (e *ephemeralSecrets) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"plaintext": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"secret": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[secretModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"context": schema.MapAttribute{
+							CustomType:  fwtypes.MapOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"encryption_algorithm": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.EncryptionAlgorithmSpec](),
+							Optional:   true,
+						},
+						"grant_tokens": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						names.AttrKeyID: schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"payload": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (e *ephemeralSecrets) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data ephemeralSecretsModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().KMSClient(ctx)
+
+	secrets, diags := data.Secrets.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	plaintext := make(map[string]string, len(secrets))
+
+	for _, secret := range secrets {
+		name := secret.Name.ValueString()
+
+		// base64 decode the payload
+		payload, err := itypes.Base64Decode(secret.Payload.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("invalid base64 value for secret (%s)", name), err.Error())
+
+			return
+		}
+
+		input := &kms.DecryptInput{
+			CiphertextBlob:      payload,
+			EncryptionAlgorithm: secret.EncryptionAlgorithm.ValueEnum(),
+			EncryptionContext:   fwflex.ExpandFrameworkStringValueMap(ctx, secret.Context),
+			GrantTokens:         fwflex.ExpandFrameworkStringValueList(ctx, secret.GrantTokens),
+			KeyId:               fwflex.StringFromFramework(ctx, secret.KeyID),
+		}
+
+		output, err := conn.Decrypt(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("decrypting KMS Secret (%s)", name), err.Error())
+
+			return
+		}
+
+		plaintext[name] = string(output.Plaintext)
+	}
+
+	data.Plaintext = fwflex.FlattenFrameworkStringValueMapLegacy(ctx, plaintext)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type ephemeralSecretsModel struct {
+	Plaintext types.Map                                   `tfsdk:"plaintext"`
+	Secrets   fwtypes.SetNestedObjectValueOf[secretModel] `tfsdk:"secret"`
+}
+
+type secretModel struct {
+	Context             fwtypes.MapOfString                                  `tfsdk:"context"`
+	EncryptionAlgorithm fwtypes.StringEnum[awstypes.EncryptionAlgorithmSpec] `tfsdk:"encryption_algorithm"`
+	GrantTokens         fwtypes.ListValueOf[types.String]                    `tfsdk:"grant_tokens"`
+	KeyID               types.String                                         `tfsdk:"key_id"`
+	Name                types.String                                         `tfsdk:"name"`
+	Payload             types.String                                         `tfsdk:"payload"`
+}
@@ -17,12 +17,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_lambda_function", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lambda_function", &resource.Sweeper{
 		Name: "aws_lambda_function",
 		F:    sweepFunctions,
 	})
 
-	resource.AddTestSweepers("aws_lambda_layer", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lambda_layer", &resource.Sweeper{
 		Name: "aws_lambda_layer",
 		F:    sweepLayerVersions,
 		Dependencies: []string{
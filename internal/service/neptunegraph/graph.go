@@ -0,0 +1,474 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package neptunegraph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/neptunegraph"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/neptunegraph/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_neptunegraph_graph", name="Graph")
+// @Tags(identifierAttribute="arn")
+func newGraphResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &graphResource{}
+
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+	r.SetDefaultUpdateTimeout(60 * time.Minute)
+	r.SetDefaultDeleteTimeout(60 * time.Minute)
+
+	return r, nil
+}
+
+type graphResource struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+	framework.WithImportByID
+}
+
+func (*graphResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_neptunegraph_graph"
+}
+
+func (r *graphResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDeletionProtection: schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrEndpoint: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"graph_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"kms_key_identifier": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"provisioned_memory": schema.Int32Attribute{
+				Required: true,
+				Validators: []validator.Int32{
+					int32validator.Between(16, 24576),
+				},
+			},
+			"public_connectivity": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"replica_count": schema.Int32Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.Between(0, 2),
+				},
+			},
+			"skip_final_snapshot": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"source_snapshot_id": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+			"vector_search_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[vectorSearchConfigurationModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"dimension": schema.Int32Attribute{
+							Required: true,
+							Validators: []validator.Int32{
+								int32validator.Between(1, 65536),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *graphResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data graphResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().NeptuneGraphClient(ctx)
+
+	name := data.GraphName.ValueString()
+	var id string
+
+	if !data.SourceSnapshotID.IsNull() {
+		input := &neptunegraph.RestoreGraphFromSnapshotInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.SnapshotIdentifier = data.SourceSnapshotID.ValueStringPointer()
+		input.Tags = getTagsIn(ctx)
+
+		output, err := conn.RestoreGraphFromSnapshot(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("creating Neptune Analytics Graph (%s) from snapshot (%s)", name, data.SourceSnapshotID.ValueString()), err.Error())
+
+			return
+		}
+
+		id = aws.ToString(output.Id)
+	} else {
+		input := &neptunegraph.CreateGraphInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.Tags = getTagsIn(ctx)
+
+		output, err := conn.CreateGraph(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("creating Neptune Analytics Graph (%s)", name), err.Error())
+
+			return
+		}
+
+		id = aws.ToString(output.Id)
+	}
+
+	data.ID = types.StringValue(id)
+
+	output, err := waitGraphCreated(ctx, conn, id, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Neptune Analytics Graph (%s) create", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *graphResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data graphResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().NeptuneGraphClient(ctx)
+
+	output, err := findGraphByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Neptune Analytics Graph (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.GraphName = fwflex.StringToFramework(ctx, output.Name)
+	if data.SkipFinalSnapshot.IsNull() {
+		data.SkipFinalSnapshot = types.BoolValue(false)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *graphResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new graphResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().NeptuneGraphClient(ctx)
+
+	if !new.DeletionProtection.Equal(old.DeletionProtection) ||
+		!new.ProvisionedMemory.Equal(old.ProvisionedMemory) ||
+		!new.PublicConnectivity.Equal(old.PublicConnectivity) {
+		input := &neptunegraph.UpdateGraphInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.GraphIdentifier = new.ID.ValueStringPointer()
+
+		_, err := conn.UpdateGraph(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Neptune Analytics Graph (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		if _, err := waitGraphUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Neptune Analytics Graph (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *graphResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data graphResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().NeptuneGraphClient(ctx)
+
+	_, err := conn.DeleteGraph(ctx, &neptunegraph.DeleteGraphInput{
+		GraphIdentifier: data.ID.ValueStringPointer(),
+		SkipSnapshot:    data.SkipFinalSnapshot.ValueBoolPointer(),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Neptune Analytics Graph (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitGraphDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Neptune Analytics Graph (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *graphResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findGraphByID(ctx context.Context, conn *neptunegraph.Client, id string) (*neptunegraph.GetGraphOutput, error) {
+	input := &neptunegraph.GetGraphInput{
+		GraphIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetGraph(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusGraph(ctx context.Context, conn *neptunegraph.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findGraphByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitGraphCreated(ctx context.Context, conn *neptunegraph.Client, id string, timeout time.Duration) (*neptunegraph.GetGraphOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.GraphStatusCreating),
+		Target:  enum.Slice(awstypes.GraphStatusAvailable),
+		Refresh: statusGraph(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*neptunegraph.GetGraphOutput); ok {
+		if output.Status == awstypes.GraphStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitGraphUpdated(ctx context.Context, conn *neptunegraph.Client, id string, timeout time.Duration) (*neptunegraph.GetGraphOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.GraphStatusUpdating),
+		Target:  enum.Slice(awstypes.GraphStatusAvailable),
+		Refresh: statusGraph(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*neptunegraph.GetGraphOutput); ok {
+		if output.Status == awstypes.GraphStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitGraphDeleted(ctx context.Context, conn *neptunegraph.Client, id string, timeout time.Duration) (*neptunegraph.GetGraphOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.GraphStatusAvailable, awstypes.GraphStatusDeleting),
+		Target:  []string{},
+		Refresh: statusGraph(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*neptunegraph.GetGraphOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type graphResourceModel struct {
+	ARN                       types.String                                                    `tfsdk:"arn"`
+	DeletionProtection        types.Bool                                                      `tfsdk:"deletion_protection"`
+	Endpoint                  types.String                                                    `tfsdk:"endpoint"`
+	GraphName                 types.String                                                    `tfsdk:"graph_name"`
+	ID                        types.String                                                    `tfsdk:"id"`
+	KMSKeyIdentifier          types.String                                                    `tfsdk:"kms_key_identifier"`
+	ProvisionedMemory         types.Int32                                                     `tfsdk:"provisioned_memory"`
+	PublicConnectivity        types.Bool                                                      `tfsdk:"public_connectivity"`
+	ReplicaCount              types.Int32                                                     `tfsdk:"replica_count"`
+	SkipFinalSnapshot         types.Bool                                                      `tfsdk:"skip_final_snapshot"`
+	SourceSnapshotID          types.String                                                    `tfsdk:"source_snapshot_id"`
+	Tags                      tftags.Map                                                      `tfsdk:"tags"`
+	TagsAll                   tftags.Map                                                      `tfsdk:"tags_all"`
+	Timeouts                  timeouts.Value                                                  `tfsdk:"timeouts"`
+	VectorSearchConfiguration fwtypes.ListNestedObjectValueOf[vectorSearchConfigurationModel] `tfsdk:"vector_search_configuration"`
+}
+
+type vectorSearchConfigurationModel struct {
+	Dimension types.Int32 `tfsdk:"dimension"`
+}
@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package neptunegraph
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/neptunegraph"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/neptunegraph/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_neptunegraph_graph", name="Graph")
+// @Tags(identifierAttribute="arn")
+func newGraphDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &graphDataSource{}, nil
+}
+
+type graphDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*graphDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_neptunegraph_graph"
+}
+
+func (d *graphDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrDeletionProtection: schema.BoolAttribute{
+				Computed: true,
+			},
+			names.AttrEndpoint: schema.StringAttribute{
+				Computed: true,
+			},
+			"graph_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"kms_key_identifier": schema.StringAttribute{
+				Computed: true,
+			},
+			"provisioned_memory": schema.Int32Attribute{
+				Computed: true,
+			},
+			"public_connectivity": schema.BoolAttribute{
+				Computed: true,
+			},
+			"replica_count": schema.Int32Attribute{
+				Computed: true,
+			},
+			"source_snapshot_id": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.GraphStatus](),
+				Computed:   true,
+			},
+			names.AttrTags:                tftags.TagsAttributeComputedOnly(),
+			"vector_search_configuration": framework.DataSourceComputedListOfObjectAttribute[vectorSearchConfigurationModel](ctx),
+		},
+	}
+}
+
+func (d *graphDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("graph_name"),
+			path.MatchRoot(names.AttrID),
+		),
+	}
+}
+
+func (d *graphDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data graphDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().NeptuneGraphClient(ctx)
+
+	id := data.ID.ValueString()
+	if id == "" {
+		name := data.GraphName.ValueString()
+		graph, err := findGraph(ctx, conn, &neptunegraph.ListGraphsInput{}, func(v *awstypes.GraphSummary) bool {
+			return aws.ToString(v.Name) == name
+		})
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Neptune Analytics Graph (%s)", name), tfresource.SingularDataSourceFindError("Neptune Analytics Graph", err).Error())
+
+			return
+		}
+
+		id = aws.ToString(graph.Id)
+	}
+
+	output, err := findGraphByID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Neptune Analytics Graph (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.GraphName = fwflex.StringToFramework(ctx, output.Name)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findGraph(ctx context.Context, conn *neptunegraph.Client, input *neptunegraph.ListGraphsInput, filter tfslices.Predicate[*awstypes.GraphSummary]) (*awstypes.GraphSummary, error) {
+	output, err := findGraphs(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findGraphs(ctx context.Context, conn *neptunegraph.Client, input *neptunegraph.ListGraphsInput, filter tfslices.Predicate[*awstypes.GraphSummary]) ([]awstypes.GraphSummary, error) {
+	var output []awstypes.GraphSummary
+
+	pages := neptunegraph.NewListGraphsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Graphs {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+type graphDataSourceModel struct {
+	ARN                       types.String                                                    `tfsdk:"arn"`
+	DeletionProtection        types.Bool                                                      `tfsdk:"deletion_protection"`
+	Endpoint                  types.String                                                    `tfsdk:"endpoint"`
+	GraphName                 types.String                                                    `tfsdk:"graph_name"`
+	ID                        types.String                                                    `tfsdk:"id"`
+	KMSKeyIdentifier          types.String                                                    `tfsdk:"kms_key_identifier"`
+	ProvisionedMemory         types.Int32                                                     `tfsdk:"provisioned_memory"`
+	PublicConnectivity        types.Bool                                                      `tfsdk:"public_connectivity"`
+	ReplicaCount              types.Int32                                                     `tfsdk:"replica_count"`
+	SourceSnapshotID          types.String                                                    `tfsdk:"source_snapshot_id"`
+	Status                    fwtypes.StringEnum[awstypes.GraphStatus]                        `tfsdk:"status"`
+	Tags                      tftags.Map                                                      `tfsdk:"tags"`
+	VectorSearchConfiguration fwtypes.ListNestedObjectValueOf[vectorSearchConfigurationModel] `tfsdk:"vector_search_configuration"`
+}
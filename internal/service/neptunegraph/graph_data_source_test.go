@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package neptunegraph_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccNeptuneGraphGraphDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_neptunegraph_graph.test"
+	dataSourceByIDName := "data.aws_neptunegraph_graph.by_id"
+	dataSourceByNameName := "data.aws_neptunegraph_graph.by_name"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.NeptuneGraphServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGraphDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceByIDName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceByIDName, names.AttrEndpoint, resourceName, names.AttrEndpoint),
+					resource.TestCheckResourceAttrPair(dataSourceByIDName, "graph_name", resourceName, "graph_name"),
+					resource.TestCheckResourceAttrPair(dataSourceByIDName, "provisioned_memory", resourceName, "provisioned_memory"),
+					resource.TestCheckResourceAttr(dataSourceByIDName, names.AttrStatus, "AVAILABLE"),
+					resource.TestCheckResourceAttr(dataSourceByIDName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(dataSourceByIDName, acctest.CtTagsKey1, acctest.CtValue1),
+					resource.TestCheckResourceAttrPair(dataSourceByNameName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceByNameName, names.AttrID, resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceByNameName, acctest.CtTagsPercent, "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGraphDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccGraphConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1), `
+data "aws_neptunegraph_graph" "by_id" {
+  id = aws_neptunegraph_graph.test.id
+}
+
+data "aws_neptunegraph_graph" "by_name" {
+  graph_name = aws_neptunegraph_graph.test.graph_name
+}
+`)
+}
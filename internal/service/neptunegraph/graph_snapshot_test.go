@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package neptunegraph_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/neptunegraph"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfneptunegraph "github.com/hashicorp/terraform-provider-aws/internal/service/neptunegraph"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccNeptuneGraphGraphSnapshot_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var snapshot neptunegraph.GetGraphSnapshotOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_neptunegraph_graph_snapshot.test"
+	graphResourceName := "aws_neptunegraph_graph.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.NeptuneGraphServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGraphSnapshotDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGraphSnapshotConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGraphSnapshotExists(ctx, resourceName, &snapshot),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrSet(resourceName, "snapshot_create_time"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_graph_id", graphResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNeptuneGraphGraphSnapshot_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var snapshot neptunegraph.GetGraphSnapshotOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_neptunegraph_graph_snapshot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.NeptuneGraphServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGraphSnapshotDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGraphSnapshotConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGraphSnapshotExists(ctx, resourceName, &snapshot),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfneptunegraph.ResourceGraphSnapshot, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGraphSnapshotDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NeptuneGraphClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_neptunegraph_graph_snapshot" {
+				continue
+			}
+
+			_, err := tfneptunegraph.FindGraphSnapshotByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Neptune Analytics Graph Snapshot %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGraphSnapshotExists(ctx context.Context, n string, v *neptunegraph.GetGraphSnapshotOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NeptuneGraphClient(ctx)
+
+		output, err := tfneptunegraph.FindGraphSnapshotByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccGraphSnapshotConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccGraphConfig_basic(rName, 16), fmt.Sprintf(`
+resource "aws_neptunegraph_graph_snapshot" "test" {
+  name            = %[1]q
+  source_graph_id = aws_neptunegraph_graph.test.id
+}
+`, rName))
+}
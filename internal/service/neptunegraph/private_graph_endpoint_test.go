@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package neptunegraph_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/neptunegraph"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfneptunegraph "github.com/hashicorp/terraform-provider-aws/internal/service/neptunegraph"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccNeptuneGraphPrivateGraphEndpoint_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var endpoint neptunegraph.GetPrivateGraphEndpointOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_neptunegraph_private_graph_endpoint.test"
+	graphResourceName := "aws_neptunegraph_graph.test"
+	vpcResourceName := "aws_vpc.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.NeptuneGraphServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPrivateGraphEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrivateGraphEndpointConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPrivateGraphEndpointExists(ctx, resourceName, &endpoint),
+					resource.TestCheckResourceAttrPair(resourceName, "graph_identifier", graphResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "vpc_endpoint_id"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrVPCID, vpcResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "vpc_security_group_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"vpc_security_group_ids"},
+			},
+		},
+	})
+}
+
+func TestAccNeptuneGraphPrivateGraphEndpoint_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var endpoint neptunegraph.GetPrivateGraphEndpointOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_neptunegraph_private_graph_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.NeptuneGraphServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPrivateGraphEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrivateGraphEndpointConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPrivateGraphEndpointExists(ctx, resourceName, &endpoint),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfneptunegraph.ResourcePrivateGraphEndpoint, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPrivateGraphEndpointDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NeptuneGraphClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_neptunegraph_private_graph_endpoint" {
+				continue
+			}
+
+			_, err := tfneptunegraph.FindPrivateGraphEndpointByTwoPartKey(ctx, conn, rs.Primary.Attributes["graph_identifier"], rs.Primary.Attributes[names.AttrVPCID])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Neptune Analytics Private Graph Endpoint %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPrivateGraphEndpointExists(ctx context.Context, n string, v *neptunegraph.GetPrivateGraphEndpointOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NeptuneGraphClient(ctx)
+
+		output, err := tfneptunegraph.FindPrivateGraphEndpointByTwoPartKey(ctx, conn, rs.Primary.Attributes["graph_identifier"], rs.Primary.Attributes[names.AttrVPCID])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPrivateGraphEndpointConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccGraphConfig_basic(rName, 16), acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_neptunegraph_private_graph_endpoint" "test" {
+  graph_identifier       = aws_neptunegraph_graph.test.id
+  vpc_id                 = aws_vpc.test.id
+  subnet_ids             = aws_subnet.test[*].id
+  vpc_security_group_ids = [aws_security_group.test.id]
+}
+`, rName))
+}
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package neptunegraph
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/neptunegraph"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_neptunegraph_graph", sweepGraphs, "aws_neptunegraph_private_graph_endpoint")
+	awsv2.Register("aws_neptunegraph_graph_snapshot", sweepGraphSnapshots)
+	awsv2.Register("aws_neptunegraph_private_graph_endpoint", sweepPrivateGraphEndpoints)
+}
+
+func sweepGraphs(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.NeptuneGraphClient(ctx)
+	input := &neptunegraph.ListGraphsInput{}
+	var sweepResources []sweep.Sweepable
+
+	pages := neptunegraph.NewListGraphsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Graphs {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newGraphResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.Id)),
+				framework.NewAttribute("skip_final_snapshot", true)))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepGraphSnapshots(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.NeptuneGraphClient(ctx)
+	input := &neptunegraph.ListGraphSnapshotsInput{}
+	var sweepResources []sweep.Sweepable
+
+	pages := neptunegraph.NewListGraphSnapshotsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.GraphSnapshots {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newGraphSnapshotResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.Id))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepPrivateGraphEndpoints(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.NeptuneGraphClient(ctx)
+	input := &neptunegraph.ListGraphsInput{}
+	var sweepResources []sweep.Sweepable
+
+	pages := neptunegraph.NewListGraphsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Graphs {
+			graphID := aws.ToString(v.Id)
+			input := &neptunegraph.ListPrivateGraphEndpointsInput{
+				GraphIdentifier: aws.String(graphID),
+			}
+
+			pages := neptunegraph.NewListPrivateGraphEndpointsPaginator(conn, input)
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+
+				if err != nil {
+					return nil, err
+				}
+
+				for _, v := range page.PrivateGraphEndpoints {
+					sweepResources = append(sweepResources, framework.NewSweepResource(newPrivateGraphEndpointResource, client,
+						framework.NewAttribute("graph_identifier", graphID),
+						framework.NewAttribute(names.AttrVPCID, aws.ToString(v.VpcId))))
+				}
+			}
+		}
+	}
+
+	return sweepResources, nil
+}
@@ -0,0 +1,462 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pcs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcs/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_pcs_cluster", name="Cluster")
+// @Tags(identifierAttribute="arn")
+func newClusterResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &clusterResource{}
+
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+	r.SetDefaultDeleteTimeout(60 * time.Minute)
+
+	return r, nil
+}
+
+type clusterResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpUpdate[clusterResourceModel]
+	framework.WithTimeouts
+	framework.WithImportByID
+}
+
+func (*clusterResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_pcs_cluster"
+}
+
+func (r *clusterResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"endpoints": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[endpointModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[endpointModel](ctx),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 40),
+				},
+			},
+			names.AttrSize: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Size](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"networking": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[networkingModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrSecurityGroupIDs: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+							Computed:    true,
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.RequiresReplace(),
+								setplanmodifier.UseStateForUnknown(),
+							},
+						},
+						names.AttrSubnetIDs: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.RequiresReplace(),
+							},
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"scheduler": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[schedulerModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrType: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.SchedulerType](),
+							Required:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+						names.AttrVersion: schema.StringAttribute{
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+				},
+			},
+			"slurm_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[clusterSlurmConfigurationModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"scale_down_idle_time_in_seconds": schema.Int32Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Int32{
+								int32planmodifier.RequiresReplace(),
+								int32planmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"slurm_custom_settings": slurmCustomSettingsBlock(ctx, listplanmodifier.RequiresReplace()),
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *clusterResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data clusterResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &pcs.CreateClusterInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input, fwflex.WithFieldNamePrefix("Cluster"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateCluster(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating PCS Cluster (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringToFramework(ctx, output.Cluster.Id)
+
+	cluster, err := waitClusterCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for PCS Cluster (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	slurmConfiguration := data.SlurmConfiguration
+	response.Diagnostics.Append(fwflex.Flatten(ctx, cluster, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Slurm configuration is always returned; only track it when configured.
+	if slurmConfiguration.IsNull() {
+		data.SlurmConfiguration = slurmConfiguration
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *clusterResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data clusterResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	output, err := findClusterByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading PCS Cluster (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	slurmConfiguration := data.SlurmConfiguration
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if slurmConfiguration.IsNull() {
+		data.SlurmConfiguration = slurmConfiguration
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *clusterResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data clusterResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	// The cluster can't be deleted until all of its compute node groups and queues are gone.
+	_, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, r.DeleteTimeout(ctx, data.Timeouts), func() (interface{}, error) {
+		return conn.DeleteCluster(ctx, &pcs.DeleteClusterInput{
+			ClientToken:       aws.String(sdkid.UniqueId()),
+			ClusterIdentifier: data.ID.ValueStringPointer(),
+		})
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting PCS Cluster (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitClusterDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for PCS Cluster (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *clusterResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findClusterByID(ctx context.Context, conn *pcs.Client, id string) (*awstypes.Cluster, error) {
+	input := &pcs.GetClusterInput{
+		ClusterIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetCluster(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Cluster == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Cluster, nil
+}
+
+func statusCluster(ctx context.Context, conn *pcs.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findClusterByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitClusterCreated(ctx context.Context, conn *pcs.Client, id string, timeout time.Duration) (*awstypes.Cluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.ClusterStatusCreating),
+		Target:     enum.Slice(awstypes.ClusterStatusActive),
+		Refresh:    statusCluster(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Cluster); ok {
+		tfresource.SetLastError(err, errorInfosError(output.ErrorInfo))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitClusterDeleted(ctx context.Context, conn *pcs.Client, id string, timeout time.Duration) (*awstypes.Cluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.ClusterStatusDeleting),
+		Target:     []string{},
+		Refresh:    statusCluster(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Cluster); ok {
+		tfresource.SetLastError(err, errorInfosError(output.ErrorInfo))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func slurmCustomSettingsBlock(ctx context.Context, planModifiers ...planmodifier.List) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType:    fwtypes.NewListNestedObjectTypeOf[slurmCustomSettingModel](ctx),
+		PlanModifiers: planModifiers,
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"parameter_name": schema.StringAttribute{
+					Required: true,
+				},
+				"parameter_value": schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+type clusterResourceModel struct {
+	ARN                types.String                                                    `tfsdk:"arn"`
+	Endpoints          fwtypes.ListNestedObjectValueOf[endpointModel]                  `tfsdk:"endpoints"`
+	ID                 types.String                                                    `tfsdk:"id"`
+	Name               types.String                                                    `tfsdk:"name"`
+	Networking         fwtypes.ListNestedObjectValueOf[networkingModel]                `tfsdk:"networking"`
+	Scheduler          fwtypes.ListNestedObjectValueOf[schedulerModel]                 `tfsdk:"scheduler"`
+	Size               fwtypes.StringEnum[awstypes.Size]                               `tfsdk:"size"`
+	SlurmConfiguration fwtypes.ListNestedObjectValueOf[clusterSlurmConfigurationModel] `tfsdk:"slurm_configuration"`
+	Tags               tftags.Map                                                      `tfsdk:"tags"`
+	TagsAll            tftags.Map                                                      `tfsdk:"tags_all"`
+	Timeouts           timeouts.Value                                                  `tfsdk:"timeouts"`
+}
+
+type endpointModel struct {
+	Port             types.String                              `tfsdk:"port"`
+	PrivateIPAddress types.String                              `tfsdk:"private_ip_address"`
+	PublicIPAddress  types.String                              `tfsdk:"public_ip_address"`
+	Type             fwtypes.StringEnum[awstypes.EndpointType] `tfsdk:"type"`
+}
+
+type networkingModel struct {
+	SecurityGroupIDs fwtypes.SetOfString `tfsdk:"security_group_ids"`
+	SubnetIDs        fwtypes.SetOfString `tfsdk:"subnet_ids"`
+}
+
+type schedulerModel struct {
+	Type    fwtypes.StringEnum[awstypes.SchedulerType] `tfsdk:"type"`
+	Version types.String                               `tfsdk:"version"`
+}
+
+type clusterSlurmConfigurationModel struct {
+	ScaleDownIdleTimeInSeconds types.Int32                                              `tfsdk:"scale_down_idle_time_in_seconds"`
+	SlurmCustomSettings        fwtypes.ListNestedObjectValueOf[slurmCustomSettingModel] `tfsdk:"slurm_custom_settings"`
+}
+
+type slurmCustomSettingModel struct {
+	ParameterName  types.String `tfsdk:"parameter_name"`
+	ParameterValue types.String `tfsdk:"parameter_value"`
+}
@@ -0,0 +1,591 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pcs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcs/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_pcs_compute_node_group", name="Compute Node Group")
+// @Tags(identifierAttribute="arn")
+func newComputeNodeGroupResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &computeNodeGroupResource{}
+
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+	r.SetDefaultUpdateTimeout(60 * time.Minute)
+	r.SetDefaultDeleteTimeout(60 * time.Minute)
+
+	return r, nil
+}
+
+type computeNodeGroupResource struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+	framework.WithImportByID
+}
+
+func (*computeNodeGroupResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_pcs_compute_node_group"
+}
+
+func (r *computeNodeGroupResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"ami_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"cluster_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"compute_node_group_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"iam_instance_profile_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 25),
+				},
+			},
+			"purchase_option": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PurchaseOption](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrSubnetIDs: schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"custom_launch_template": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[customLaunchTemplateModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrID: schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrVersion: schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"instance_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[instanceConfigModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrInstanceType: schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"scaling_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[scalingConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_instance_count": schema.Int32Attribute{
+							Required: true,
+							Validators: []validator.Int32{
+								int32validator.AtLeast(1),
+							},
+						},
+						"min_instance_count": schema.Int32Attribute{
+							Required: true,
+							Validators: []validator.Int32{
+								int32validator.AtLeast(0),
+							},
+						},
+					},
+				},
+			},
+			"slurm_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[computeNodeGroupSlurmConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"slurm_custom_settings": slurmCustomSettingsBlock(ctx),
+					},
+				},
+			},
+			"spot_options": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[spotOptionsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"allocation_strategy": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.SpotAllocationStrategy](),
+							Optional:   true,
+							Computed:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *computeNodeGroupResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data computeNodeGroupResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &pcs.CreateComputeNodeGroupInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input, fwflex.WithFieldNamePrefix("ComputeNodeGroup"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.ClusterIdentifier = data.ClusterID.ValueStringPointer()
+	input.Tags = getTagsIn(ctx)
+
+	// The cluster rejects changes while another of its resources is being modified.
+	outputRaw, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, r.CreateTimeout(ctx, data.Timeouts), func() (interface{}, error) {
+		return conn.CreateComputeNodeGroup(ctx, input)
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating PCS Compute Node Group (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ComputeNodeGroupID = fwflex.StringToFramework(ctx, outputRaw.(*pcs.CreateComputeNodeGroupOutput).ComputeNodeGroup.Id)
+	id, err := data.setID()
+	if err != nil {
+		response.Diagnostics.AddError("flattening resource ID PCS Compute Node Group", err.Error())
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	computeNodeGroup, err := waitComputeNodeGroupCreated(ctx, conn, data.ClusterID.ValueString(), data.ComputeNodeGroupID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for PCS Compute Node Group (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	slurmConfiguration := data.SlurmConfiguration
+	response.Diagnostics.Append(fwflex.Flatten(ctx, computeNodeGroup, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Slurm configuration is always returned; only track it when configured.
+	if slurmConfiguration.IsNull() {
+		data.SlurmConfiguration = slurmConfiguration
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *computeNodeGroupResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data computeNodeGroupResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	output, err := findComputeNodeGroupByTwoPartKey(ctx, conn, data.ClusterID.ValueString(), data.ComputeNodeGroupID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading PCS Compute Node Group (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	slurmConfiguration := data.SlurmConfiguration
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if slurmConfiguration.IsNull() {
+		data.SlurmConfiguration = slurmConfiguration
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *computeNodeGroupResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new computeNodeGroupResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	diff, d := fwflex.Calculate(ctx, new, old)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		input := &pcs.UpdateComputeNodeGroupInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.ClientToken = aws.String(sdkid.UniqueId())
+		input.ClusterIdentifier = new.ClusterID.ValueStringPointer()
+		input.ComputeNodeGroupIdentifier = new.ComputeNodeGroupID.ValueStringPointer()
+
+		_, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, r.UpdateTimeout(ctx, new.Timeouts), func() (interface{}, error) {
+			return conn.UpdateComputeNodeGroup(ctx, input)
+		})
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating PCS Compute Node Group (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		if _, err := waitComputeNodeGroupUpdated(ctx, conn, new.ClusterID.ValueString(), new.ComputeNodeGroupID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for PCS Compute Node Group (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *computeNodeGroupResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data computeNodeGroupResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	// The compute node group can't be deleted while it's associated with a queue.
+	_, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, r.DeleteTimeout(ctx, data.Timeouts), func() (interface{}, error) {
+		return conn.DeleteComputeNodeGroup(ctx, &pcs.DeleteComputeNodeGroupInput{
+			ClientToken:                aws.String(sdkid.UniqueId()),
+			ClusterIdentifier:          data.ClusterID.ValueStringPointer(),
+			ComputeNodeGroupIdentifier: data.ComputeNodeGroupID.ValueStringPointer(),
+		})
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting PCS Compute Node Group (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitComputeNodeGroupDeleted(ctx, conn, data.ClusterID.ValueString(), data.ComputeNodeGroupID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for PCS Compute Node Group (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *computeNodeGroupResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findComputeNodeGroupByTwoPartKey(ctx context.Context, conn *pcs.Client, clusterID, computeNodeGroupID string) (*awstypes.ComputeNodeGroup, error) {
+	input := &pcs.GetComputeNodeGroupInput{
+		ClusterIdentifier:          aws.String(clusterID),
+		ComputeNodeGroupIdentifier: aws.String(computeNodeGroupID),
+	}
+
+	output, err := conn.GetComputeNodeGroup(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ComputeNodeGroup == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.ComputeNodeGroup.Status; status == awstypes.ComputeNodeGroupStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output.ComputeNodeGroup, nil
+}
+
+func statusComputeNodeGroup(ctx context.Context, conn *pcs.Client, clusterID, computeNodeGroupID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findComputeNodeGroupByTwoPartKey(ctx, conn, clusterID, computeNodeGroupID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitComputeNodeGroupCreated(ctx context.Context, conn *pcs.Client, clusterID, computeNodeGroupID string, timeout time.Duration) (*awstypes.ComputeNodeGroup, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.ComputeNodeGroupStatusCreating),
+		Target:     enum.Slice(awstypes.ComputeNodeGroupStatusActive),
+		Refresh:    statusComputeNodeGroup(ctx, conn, clusterID, computeNodeGroupID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ComputeNodeGroup); ok {
+		tfresource.SetLastError(err, errorInfosError(output.ErrorInfo))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitComputeNodeGroupUpdated(ctx context.Context, conn *pcs.Client, clusterID, computeNodeGroupID string, timeout time.Duration) (*awstypes.ComputeNodeGroup, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.ComputeNodeGroupStatusUpdating),
+		Target:     enum.Slice(awstypes.ComputeNodeGroupStatusActive),
+		Refresh:    statusComputeNodeGroup(ctx, conn, clusterID, computeNodeGroupID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ComputeNodeGroup); ok {
+		tfresource.SetLastError(err, errorInfosError(output.ErrorInfo))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitComputeNodeGroupDeleted(ctx context.Context, conn *pcs.Client, clusterID, computeNodeGroupID string, timeout time.Duration) (*awstypes.ComputeNodeGroup, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.ComputeNodeGroupStatusDeleting),
+		Target:     []string{},
+		Refresh:    statusComputeNodeGroup(ctx, conn, clusterID, computeNodeGroupID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ComputeNodeGroup); ok {
+		tfresource.SetLastError(err, errorInfosError(output.ErrorInfo))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type computeNodeGroupResourceModel struct {
+	AMIID                 types.String                                                             `tfsdk:"ami_id"`
+	ARN                   types.String                                                             `tfsdk:"arn"`
+	ClusterID             types.String                                                             `tfsdk:"cluster_id"`
+	ComputeNodeGroupID    types.String                                                             `tfsdk:"compute_node_group_id" autoflex:"Id"`
+	CustomLaunchTemplate  fwtypes.ListNestedObjectValueOf[customLaunchTemplateModel]               `tfsdk:"custom_launch_template"`
+	IAMInstanceProfileARN fwtypes.ARN                                                              `tfsdk:"iam_instance_profile_arn"`
+	ID                    types.String                                                             `tfsdk:"id" autoflex:"-"`
+	InstanceConfigs       fwtypes.ListNestedObjectValueOf[instanceConfigModel]                     `tfsdk:"instance_config"`
+	Name                  types.String                                                             `tfsdk:"name"`
+	PurchaseOption        fwtypes.StringEnum[awstypes.PurchaseOption]                              `tfsdk:"purchase_option"`
+	ScalingConfiguration  fwtypes.ListNestedObjectValueOf[scalingConfigurationModel]               `tfsdk:"scaling_configuration"`
+	SlurmConfiguration    fwtypes.ListNestedObjectValueOf[computeNodeGroupSlurmConfigurationModel] `tfsdk:"slurm_configuration"`
+	SpotOptions           fwtypes.ListNestedObjectValueOf[spotOptionsModel]                        `tfsdk:"spot_options"`
+	SubnetIDs             fwtypes.SetOfString                                                      `tfsdk:"subnet_ids"`
+	Tags                  tftags.Map                                                               `tfsdk:"tags"`
+	TagsAll               tftags.Map                                                               `tfsdk:"tags_all"`
+	Timeouts              timeouts.Value                                                           `tfsdk:"timeouts"`
+}
+
+type customLaunchTemplateModel struct {
+	ID      types.String `tfsdk:"id"`
+	Version types.String `tfsdk:"version"`
+}
+
+type instanceConfigModel struct {
+	InstanceType types.String `tfsdk:"instance_type"`
+}
+
+type scalingConfigurationModel struct {
+	MaxInstanceCount types.Int32 `tfsdk:"max_instance_count"`
+	MinInstanceCount types.Int32 `tfsdk:"min_instance_count"`
+}
+
+type computeNodeGroupSlurmConfigurationModel struct {
+	SlurmCustomSettings fwtypes.ListNestedObjectValueOf[slurmCustomSettingModel] `tfsdk:"slurm_custom_settings"`
+}
+
+type spotOptionsModel struct {
+	AllocationStrategy fwtypes.StringEnum[awstypes.SpotAllocationStrategy] `tfsdk:"allocation_strategy"`
+}
+
+const (
+	computeNodeGroupResourceIDPartCount = 2
+)
+
+func (m *computeNodeGroupResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(m.ID.ValueString(), computeNodeGroupResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	m.ClusterID = types.StringValue(parts[0])
+	m.ComputeNodeGroupID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (m *computeNodeGroupResourceModel) setID() (string, error) {
+	parts := []string{
+		m.ClusterID.ValueString(),
+		m.ComputeNodeGroupID.ValueString(),
+	}
+
+	return flex.FlattenResourceId(parts, computeNodeGroupResourceIDPartCount, false)
+}
@@ -0,0 +1,428 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pcs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcs/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_pcs_queue", name="Queue")
+// @Tags(identifierAttribute="arn")
+func newQueueResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &queueResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type queueResource struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+	framework.WithImportByID
+}
+
+func (*queueResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_pcs_queue"
+}
+
+func (r *queueResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"cluster_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 25),
+				},
+			},
+			"queue_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"compute_node_group_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[computeNodeGroupConfigurationModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"compute_node_group_id": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *queueResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data queueResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &pcs.CreateQueueInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input, fwflex.WithFieldNamePrefix("Queue"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.ClusterIdentifier = data.ClusterID.ValueStringPointer()
+	input.Tags = getTagsIn(ctx)
+
+	// The cluster rejects changes while another of its resources is being modified.
+	outputRaw, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, r.CreateTimeout(ctx, data.Timeouts), func() (interface{}, error) {
+		return conn.CreateQueue(ctx, input)
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating PCS Queue (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.QueueID = fwflex.StringToFramework(ctx, outputRaw.(*pcs.CreateQueueOutput).Queue.Id)
+	id, err := data.setID()
+	if err != nil {
+		response.Diagnostics.AddError("flattening resource ID PCS Queue", err.Error())
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	queue, err := waitQueueCreated(ctx, conn, data.ClusterID.ValueString(), data.QueueID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for PCS Queue (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, queue, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *queueResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data queueResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	output, err := findQueueByTwoPartKey(ctx, conn, data.ClusterID.ValueString(), data.QueueID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading PCS Queue (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *queueResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new queueResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	if !new.ComputeNodeGroupConfigurations.Equal(old.ComputeNodeGroupConfigurations) {
+		input := &pcs.UpdateQueueInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.ClientToken = aws.String(sdkid.UniqueId())
+		input.ClusterIdentifier = new.ClusterID.ValueStringPointer()
+		input.QueueIdentifier = new.QueueID.ValueStringPointer()
+
+		_, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, r.UpdateTimeout(ctx, new.Timeouts), func() (interface{}, error) {
+			return conn.UpdateQueue(ctx, input)
+		})
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating PCS Queue (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		if _, err := waitQueueUpdated(ctx, conn, new.ClusterID.ValueString(), new.QueueID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for PCS Queue (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *queueResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data queueResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCSClient(ctx)
+
+	_, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, r.DeleteTimeout(ctx, data.Timeouts), func() (interface{}, error) {
+		return conn.DeleteQueue(ctx, &pcs.DeleteQueueInput{
+			ClientToken:       aws.String(sdkid.UniqueId()),
+			ClusterIdentifier: data.ClusterID.ValueStringPointer(),
+			QueueIdentifier:   data.QueueID.ValueStringPointer(),
+		})
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting PCS Queue (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitQueueDeleted(ctx, conn, data.ClusterID.ValueString(), data.QueueID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for PCS Queue (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *queueResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findQueueByTwoPartKey(ctx context.Context, conn *pcs.Client, clusterID, queueID string) (*awstypes.Queue, error) {
+	input := &pcs.GetQueueInput{
+		ClusterIdentifier: aws.String(clusterID),
+		QueueIdentifier:   aws.String(queueID),
+	}
+
+	output, err := conn.GetQueue(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Queue == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Queue, nil
+}
+
+func statusQueue(ctx context.Context, conn *pcs.Client, clusterID, queueID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findQueueByTwoPartKey(ctx, conn, clusterID, queueID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitQueueCreated(ctx context.Context, conn *pcs.Client, clusterID, queueID string, timeout time.Duration) (*awstypes.Queue, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.QueueStatusCreating),
+		Target:     enum.Slice(awstypes.QueueStatusActive),
+		Refresh:    statusQueue(ctx, conn, clusterID, queueID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Queue); ok {
+		tfresource.SetLastError(err, errorInfosError(output.ErrorInfo))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitQueueUpdated(ctx context.Context, conn *pcs.Client, clusterID, queueID string, timeout time.Duration) (*awstypes.Queue, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.QueueStatusUpdating),
+		Target:     enum.Slice(awstypes.QueueStatusActive),
+		Refresh:    statusQueue(ctx, conn, clusterID, queueID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Queue); ok {
+		tfresource.SetLastError(err, errorInfosError(output.ErrorInfo))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitQueueDeleted(ctx context.Context, conn *pcs.Client, clusterID, queueID string, timeout time.Duration) (*awstypes.Queue, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.QueueStatusDeleting),
+		Target:     []string{},
+		Refresh:    statusQueue(ctx, conn, clusterID, queueID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Queue); ok {
+		tfresource.SetLastError(err, errorInfosError(output.ErrorInfo))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type queueResourceModel struct {
+	ARN                            types.String                                                        `tfsdk:"arn"`
+	ClusterID                      types.String                                                        `tfsdk:"cluster_id"`
+	ComputeNodeGroupConfigurations fwtypes.ListNestedObjectValueOf[computeNodeGroupConfigurationModel] `tfsdk:"compute_node_group_configuration"`
+	ID                             types.String                                                        `tfsdk:"id" autoflex:"-"`
+	Name                           types.String                                                        `tfsdk:"name"`
+	QueueID                        types.String                                                        `tfsdk:"queue_id" autoflex:"Id"`
+	Tags                           tftags.Map                                                          `tfsdk:"tags"`
+	TagsAll                        tftags.Map                                                          `tfsdk:"tags_all"`
+	Timeouts                       timeouts.Value                                                      `tfsdk:"timeouts"`
+}
+
+type computeNodeGroupConfigurationModel struct {
+	ComputeNodeGroupID types.String `tfsdk:"compute_node_group_id"`
+}
+
+const (
+	queueResourceIDPartCount = 2
+)
+
+func (m *queueResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(m.ID.ValueString(), queueResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	m.ClusterID = types.StringValue(parts[0])
+	m.QueueID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (m *queueResourceModel) setID() (string, error) {
+	parts := []string{
+		m.ClusterID.ValueString(),
+		m.QueueID.ValueString(),
+	}
+
+	return flex.FlattenResourceId(parts, queueResourceIDPartCount, false)
+}
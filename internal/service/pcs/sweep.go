@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pcs"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_pcs_cluster", sweepClusters, "aws_pcs_compute_node_group")
+	awsv2.Register("aws_pcs_compute_node_group", sweepComputeNodeGroups, "aws_pcs_queue")
+	awsv2.Register("aws_pcs_queue", sweepQueues)
+}
+
+func sweepClusters(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.PCSClient(ctx)
+	var sweepResources []sweep.Sweepable
+
+	err := listClusterIDs(ctx, conn, func(clusterID string) error {
+		sweepResources = append(sweepResources, framework.NewSweepResource(newClusterResource, client,
+			framework.NewAttribute(names.AttrID, clusterID)))
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepComputeNodeGroups(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.PCSClient(ctx)
+	var sweepResources []sweep.Sweepable
+
+	err := listClusterIDs(ctx, conn, func(clusterID string) error {
+		input := &pcs.ListComputeNodeGroupsInput{
+			ClusterIdentifier: aws.String(clusterID),
+		}
+
+		pages := pcs.NewListComputeNodeGroupsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return err
+			}
+
+			for _, v := range page.ComputeNodeGroups {
+				sweepResources = append(sweepResources, framework.NewSweepResource(newComputeNodeGroupResource, client,
+					framework.NewAttribute("cluster_id", clusterID),
+					framework.NewAttribute("compute_node_group_id", aws.ToString(v.Id))))
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func sweepQueues(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.PCSClient(ctx)
+	var sweepResources []sweep.Sweepable
+
+	err := listClusterIDs(ctx, conn, func(clusterID string) error {
+		input := &pcs.ListQueuesInput{
+			ClusterIdentifier: aws.String(clusterID),
+		}
+
+		pages := pcs.NewListQueuesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return err
+			}
+
+			for _, v := range page.Queues {
+				sweepResources = append(sweepResources, framework.NewSweepResource(newQueueResource, client,
+					framework.NewAttribute("cluster_id", clusterID),
+					framework.NewAttribute("queue_id", aws.ToString(v.Id))))
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return sweepResources, nil
+}
+
+func listClusterIDs(ctx context.Context, conn *pcs.Client, f func(string) error) error {
+	input := &pcs.ListClustersInput{}
+
+	pages := pcs.NewListClustersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range page.Clusters {
+			if err := f(aws.ToString(v.Id)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,517 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/qbusiness/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_qbusiness_application", name="Application")
+// @Tags(identifierAttribute="arn")
+func newApplicationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &applicationResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type applicationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+	framework.WithImportByID
+}
+
+func (*applicationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_qbusiness_application"
+}
+
+func (r *applicationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrApplicationID: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"attachments_control_mode": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AttachmentsControlMode](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_ids_for_oidc": schema.SetAttribute{
+				CustomType: fwtypes.SetOfStringType,
+				Optional:   true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1000),
+				},
+			},
+			names.AttrDisplayName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1000),
+				},
+			},
+			"iam_identity_provider_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"identity_center_application_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"identity_center_instance_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"identity_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.IdentityType](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrKMSKeyID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"personalization_control_mode": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PersonalizationControlMode](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"q_apps_control_mode": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.QAppsControlMode](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *applicationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data applicationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	input := &qbusiness.CreateApplicationInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.AttachmentsConfiguration = data.expandAttachmentsConfiguration()
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.EncryptionConfiguration = data.expandEncryptionConfiguration()
+	input.PersonalizationConfiguration = data.expandPersonalizationConfiguration()
+	input.QAppsConfiguration = data.expandQAppsConfiguration()
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateApplication(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Amazon Q Business Application (%s)", data.DisplayName.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ApplicationARN = fwflex.StringToFramework(ctx, output.ApplicationArn)
+	data.ApplicationID = fwflex.StringToFramework(ctx, output.ApplicationId)
+	data.ID = data.ApplicationID
+
+	app, err := waitApplicationCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Amazon Q Business Application (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, app, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.flattenConfigurations(app)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *applicationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data applicationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	output, err := findApplicationByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Amazon Q Business Application (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.flattenConfigurations(output)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *applicationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new applicationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	diff, d := fwflex.Calculate(ctx, new, old)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		input := &qbusiness.UpdateApplicationInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.AttachmentsConfiguration = new.expandAttachmentsConfiguration()
+		input.PersonalizationConfiguration = new.expandPersonalizationConfiguration()
+		input.QAppsConfiguration = new.expandQAppsConfiguration()
+
+		_, err := conn.UpdateApplication(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Amazon Q Business Application (%s)", new.ApplicationID.ValueString()), err.Error())
+
+			return
+		}
+
+		if _, err := waitApplicationUpdated(ctx, conn, new.ApplicationID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Amazon Q Business Application (%s) update", new.ApplicationID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *applicationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data applicationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	_, err := conn.DeleteApplication(ctx, &qbusiness.DeleteApplicationInput{
+		ApplicationId: data.ApplicationID.ValueStringPointer(),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Amazon Q Business Application (%s)", data.ApplicationID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitApplicationDeleted(ctx, conn, data.ApplicationID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Amazon Q Business Application (%s) delete", data.ApplicationID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *applicationResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findApplicationByID(ctx context.Context, conn *qbusiness.Client, id string) (*qbusiness.GetApplicationOutput, error) {
+	input := &qbusiness.GetApplicationInput{
+		ApplicationId: aws.String(id),
+	}
+
+	output, err := conn.GetApplication(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusApplication(ctx context.Context, conn *qbusiness.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findApplicationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitApplicationCreated(ctx context.Context, conn *qbusiness.Client, id string, timeout time.Duration) (*qbusiness.GetApplicationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ApplicationStatusCreating),
+		Target:  enum.Slice(awstypes.ApplicationStatusActive),
+		Refresh: statusApplication(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetApplicationOutput); ok {
+		tfresource.SetLastError(err, errorDetailError(output.Error))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitApplicationUpdated(ctx context.Context, conn *qbusiness.Client, id string, timeout time.Duration) (*qbusiness.GetApplicationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ApplicationStatusUpdating),
+		Target:  enum.Slice(awstypes.ApplicationStatusActive),
+		Refresh: statusApplication(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetApplicationOutput); ok {
+		tfresource.SetLastError(err, errorDetailError(output.Error))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitApplicationDeleted(ctx context.Context, conn *qbusiness.Client, id string, timeout time.Duration) (*qbusiness.GetApplicationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ApplicationStatusActive, awstypes.ApplicationStatusDeleting),
+		Target:  []string{},
+		Refresh: statusApplication(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetApplicationOutput); ok {
+		tfresource.SetLastError(err, errorDetailError(output.Error))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type applicationResourceModel struct {
+	ApplicationARN               types.String                                            `tfsdk:"arn"`
+	ApplicationID                types.String                                            `tfsdk:"application_id"`
+	AttachmentsControlMode       fwtypes.StringEnum[awstypes.AttachmentsControlMode]     `tfsdk:"attachments_control_mode"`
+	ClientIdsForOIDC             fwtypes.SetValueOf[types.String]                        `tfsdk:"client_ids_for_oidc"`
+	Description                  types.String                                            `tfsdk:"description"`
+	DisplayName                  types.String                                            `tfsdk:"display_name"`
+	IAMIdentityProviderARN       fwtypes.ARN                                             `tfsdk:"iam_identity_provider_arn"`
+	IdentityCenterApplicationARN types.String                                            `tfsdk:"identity_center_application_arn"`
+	IdentityCenterInstanceARN    fwtypes.ARN                                             `tfsdk:"identity_center_instance_arn"`
+	ID                           types.String                                            `tfsdk:"id"`
+	IdentityType                 fwtypes.StringEnum[awstypes.IdentityType]               `tfsdk:"identity_type"`
+	KMSKeyID                     types.String                                            `tfsdk:"kms_key_id"`
+	PersonalizationControlMode   fwtypes.StringEnum[awstypes.PersonalizationControlMode] `tfsdk:"personalization_control_mode"`
+	QAppsControlMode             fwtypes.StringEnum[awstypes.QAppsControlMode]           `tfsdk:"q_apps_control_mode"`
+	RoleARN                      fwtypes.ARN                                             `tfsdk:"role_arn"`
+	Tags                         tftags.Map                                              `tfsdk:"tags"`
+	TagsAll                      tftags.Map                                              `tfsdk:"tags_all"`
+	Timeouts                     timeouts.Value                                          `tfsdk:"timeouts"`
+}
+
+// The application's single-valued configuration structures are flattened to top-level attributes.
+
+func (m *applicationResourceModel) expandAttachmentsConfiguration() *awstypes.AttachmentsConfiguration {
+	if m.AttachmentsControlMode.IsNull() || m.AttachmentsControlMode.IsUnknown() {
+		return nil
+	}
+
+	return &awstypes.AttachmentsConfiguration{
+		AttachmentsControlMode: m.AttachmentsControlMode.ValueEnum(),
+	}
+}
+
+func (m *applicationResourceModel) expandEncryptionConfiguration() *awstypes.EncryptionConfiguration {
+	if m.KMSKeyID.IsNull() || m.KMSKeyID.IsUnknown() {
+		return nil
+	}
+
+	return &awstypes.EncryptionConfiguration{
+		KmsKeyId: m.KMSKeyID.ValueStringPointer(),
+	}
+}
+
+func (m *applicationResourceModel) expandPersonalizationConfiguration() *awstypes.PersonalizationConfiguration {
+	if m.PersonalizationControlMode.IsNull() || m.PersonalizationControlMode.IsUnknown() {
+		return nil
+	}
+
+	return &awstypes.PersonalizationConfiguration{
+		PersonalizationControlMode: m.PersonalizationControlMode.ValueEnum(),
+	}
+}
+
+func (m *applicationResourceModel) expandQAppsConfiguration() *awstypes.QAppsConfiguration {
+	if m.QAppsControlMode.IsNull() || m.QAppsControlMode.IsUnknown() {
+		return nil
+	}
+
+	return &awstypes.QAppsConfiguration{
+		QAppsControlMode: m.QAppsControlMode.ValueEnum(),
+	}
+}
+
+func (m *applicationResourceModel) flattenConfigurations(apiObject *qbusiness.GetApplicationOutput) {
+	m.AttachmentsControlMode = fwtypes.StringEnumNull[awstypes.AttachmentsControlMode]()
+	if v := apiObject.AttachmentsConfiguration; v != nil {
+		m.AttachmentsControlMode = fwtypes.StringEnumValue(v.AttachmentsControlMode)
+	}
+
+	m.KMSKeyID = types.StringNull()
+	if v := apiObject.EncryptionConfiguration; v != nil {
+		m.KMSKeyID = types.StringPointerValue(v.KmsKeyId)
+	}
+
+	m.PersonalizationControlMode = fwtypes.StringEnumNull[awstypes.PersonalizationControlMode]()
+	if v := apiObject.PersonalizationConfiguration; v != nil {
+		m.PersonalizationControlMode = fwtypes.StringEnumValue(v.PersonalizationControlMode)
+	}
+
+	m.QAppsControlMode = fwtypes.StringEnumNull[awstypes.QAppsControlMode]()
+	if v := apiObject.QAppsConfiguration; v != nil {
+		m.QAppsControlMode = fwtypes.StringEnumValue(v.QAppsControlMode)
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/qbusiness/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_qbusiness_application", name="Application")
+// @Tags(identifierAttribute="arn")
+func newApplicationDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &applicationDataSource{}, nil
+}
+
+type applicationDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*applicationDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_qbusiness_application"
+}
+
+func (d *applicationDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrApplicationID: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			"attachments_control_mode": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AttachmentsControlMode](),
+				Computed:   true,
+			},
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrDisplayName: schema.StringAttribute{
+				Computed: true,
+			},
+			"identity_center_application_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"identity_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.IdentityType](),
+				Computed:   true,
+			},
+			names.AttrKMSKeyID: schema.StringAttribute{
+				Computed: true,
+			},
+			"personalization_control_mode": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PersonalizationControlMode](),
+				Computed:   true,
+			},
+			"q_apps_control_mode": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.QAppsControlMode](),
+				Computed:   true,
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Computed:   true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ApplicationStatus](),
+				Computed:   true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+			"updated_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (d *applicationDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data applicationDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().QBusinessClient(ctx)
+
+	output, err := findApplicationByID(ctx, conn, data.ApplicationID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Amazon Q Business Application (%s)", data.ApplicationID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.flattenConfigurations(output)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type applicationDataSourceModel struct {
+	ApplicationARN               types.String                                            `tfsdk:"arn"`
+	ApplicationID                types.String                                            `tfsdk:"application_id"`
+	AttachmentsControlMode       fwtypes.StringEnum[awstypes.AttachmentsControlMode]     `tfsdk:"attachments_control_mode"`
+	CreatedAt                    timetypes.RFC3339                                       `tfsdk:"created_at"`
+	Description                  types.String                                            `tfsdk:"description"`
+	DisplayName                  types.String                                            `tfsdk:"display_name"`
+	IdentityCenterApplicationARN types.String                                            `tfsdk:"identity_center_application_arn"`
+	IdentityType                 fwtypes.StringEnum[awstypes.IdentityType]               `tfsdk:"identity_type"`
+	KMSKeyID                     types.String                                            `tfsdk:"kms_key_id"`
+	PersonalizationControlMode   fwtypes.StringEnum[awstypes.PersonalizationControlMode] `tfsdk:"personalization_control_mode"`
+	QAppsControlMode             fwtypes.StringEnum[awstypes.QAppsControlMode]           `tfsdk:"q_apps_control_mode"`
+	RoleARN                      fwtypes.ARN                                             `tfsdk:"role_arn"`
+	Status                       fwtypes.StringEnum[awstypes.ApplicationStatus]          `tfsdk:"status"`
+	Tags                         tftags.Map                                              `tfsdk:"tags"`
+	UpdatedAt                    timetypes.RFC3339                                       `tfsdk:"updated_at"`
+}
+
+func (m *applicationDataSourceModel) flattenConfigurations(apiObject *qbusiness.GetApplicationOutput) {
+	var v applicationResourceModel
+	v.flattenConfigurations(apiObject)
+
+	m.AttachmentsControlMode = v.AttachmentsControlMode
+	m.KMSKeyID = v.KMSKeyID
+	m.PersonalizationControlMode = v.PersonalizationControlMode
+	m.QAppsControlMode = v.QAppsControlMode
+}
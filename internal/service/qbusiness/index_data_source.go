@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"context"
+	"fmt"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/qbusiness/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_qbusiness_index", name="Index")
+// @Tags(identifierAttribute="arn")
+func newIndexDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &indexDataSource{}, nil
+}
+
+type indexDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*indexDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_qbusiness_index"
+}
+
+func (d *indexDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrApplicationID: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			"capacity_configuration": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[indexCapacityConfigurationModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[indexCapacityConfigurationModel](ctx),
+				},
+			},
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrDisplayName: schema.StringAttribute{
+				Computed: true,
+			},
+			"index_id": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.IndexStatus](),
+				Computed:   true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.IndexType](),
+				Computed:   true,
+			},
+			"updated_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (d *indexDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data indexDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().QBusinessClient(ctx)
+
+	output, err := findIndexByTwoPartKey(ctx, conn, data.ApplicationID.ValueString(), data.IndexID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Amazon Q Business Index (%s)", data.IndexID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type indexDataSourceModel struct {
+	ApplicationID         types.String                                                     `tfsdk:"application_id"`
+	CapacityConfiguration fwtypes.ListNestedObjectValueOf[indexCapacityConfigurationModel] `tfsdk:"capacity_configuration"`
+	CreatedAt             timetypes.RFC3339                                                `tfsdk:"created_at"`
+	Description           types.String                                                     `tfsdk:"description"`
+	DisplayName           types.String                                                     `tfsdk:"display_name"`
+	IndexARN              types.String                                                     `tfsdk:"arn"`
+	IndexID               types.String                                                     `tfsdk:"index_id"`
+	Status                fwtypes.StringEnum[awstypes.IndexStatus]                         `tfsdk:"status"`
+	Tags                  tftags.Map                                                       `tfsdk:"tags"`
+	Type                  fwtypes.StringEnum[awstypes.IndexType]                           `tfsdk:"type"`
+	UpdatedAt             timetypes.RFC3339                                                `tfsdk:"updated_at"`
+}
@@ -0,0 +1,586 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/qbusiness/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_qbusiness_plugin", name="Plugin")
+// @Tags(identifierAttribute="arn")
+func newPluginResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &pluginResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type pluginResource struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+	framework.WithImportByID
+}
+
+func (*pluginResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_qbusiness_plugin"
+}
+
+func (r *pluginResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	secretAuthConfigurationBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[pluginSecretAuthConfigurationModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					names.AttrRoleARN: schema.StringAttribute{
+						CustomType: fwtypes.ARNType,
+						Required:   true,
+					},
+					"secret_arn": schema.StringAttribute{
+						CustomType: fwtypes.ARNType,
+						Required:   true,
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrApplicationID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"build_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PluginBuildStatus](),
+				Computed:   true,
+			},
+			names.AttrDisplayName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"plugin_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_url": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrState: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PluginState](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PluginType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"auth_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[pluginAuthConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"basic_auth_configuration": secretAuthConfigurationBlock(),
+						"no_auth_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[pluginNoAuthConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+						},
+						"oauth2_client_credential_configuration": secretAuthConfigurationBlock(),
+					},
+				},
+			},
+			"custom_plugin_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[customPluginConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"api_schema_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.APISchemaType](),
+							Required:   true,
+						},
+						names.AttrDescription: schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 200),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"api_schema": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[apiSchemaModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"payload": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"s3": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[s3Model](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrBucket: schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrKey: schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *pluginResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data pluginResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	input := &qbusiness.CreatePluginInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input, pluginFlexOptions...)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreatePlugin(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Amazon Q Business Plugin (%s)", data.DisplayName.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.PluginARN = fwflex.StringToFramework(ctx, output.PluginArn)
+	data.PluginID = fwflex.StringToFramework(ctx, output.PluginId)
+	id, err := data.setID()
+	if err != nil {
+		response.Diagnostics.AddError("flattening resource ID Amazon Q Business Plugin", err.Error())
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	plugin, err := waitPluginCreated(ctx, conn, data.ApplicationID.ValueString(), data.PluginID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Amazon Q Business Plugin (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// The plugin's state can't be specified on create.
+	if state := data.State.ValueEnum(); !data.State.IsUnknown() && state != plugin.State {
+		input := &qbusiness.UpdatePluginInput{
+			ApplicationId: data.ApplicationID.ValueStringPointer(),
+			PluginId:      data.PluginID.ValueStringPointer(),
+			State:         state,
+		}
+
+		if _, err := conn.UpdatePlugin(ctx, input); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("updating Amazon Q Business Plugin (%s) state", data.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		plugin, err = waitPluginUpdated(ctx, conn, data.ApplicationID.ValueString(), data.PluginID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+		if err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Amazon Q Business Plugin (%s) update", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	data.BuildStatus = fwtypes.StringEnumValue(plugin.BuildStatus)
+	data.State = fwtypes.StringEnumValue(plugin.State)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *pluginResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data pluginResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	output, err := findPluginByTwoPartKey(ctx, conn, data.ApplicationID.ValueString(), data.PluginID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Amazon Q Business Plugin (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, pluginFlexOptions...)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *pluginResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new pluginResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	diff, d := fwflex.Calculate(ctx, new, old)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		input := &qbusiness.UpdatePluginInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input, pluginFlexOptions...)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdatePlugin(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Amazon Q Business Plugin (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		plugin, err := waitPluginUpdated(ctx, conn, new.ApplicationID.ValueString(), new.PluginID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Amazon Q Business Plugin (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.BuildStatus = fwtypes.StringEnumValue(plugin.BuildStatus)
+	} else {
+		new.BuildStatus = old.BuildStatus
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *pluginResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data pluginResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	_, err := conn.DeletePlugin(ctx, &qbusiness.DeletePluginInput{
+		ApplicationId: data.ApplicationID.ValueStringPointer(),
+		PluginId:      data.PluginID.ValueStringPointer(),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Amazon Q Business Plugin (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitPluginDeleted(ctx, conn, data.ApplicationID.ValueString(), data.PluginID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Amazon Q Business Plugin (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *pluginResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+var pluginFlexOptions = []fwflex.AutoFlexOptionsFunc{
+	fwflex.WithUnionMembers(
+		&awstypes.APISchemaMemberPayload{},
+		&awstypes.APISchemaMemberS3{},
+		&awstypes.PluginAuthConfigurationMemberBasicAuthConfiguration{},
+		&awstypes.PluginAuthConfigurationMemberNoAuthConfiguration{},
+		&awstypes.PluginAuthConfigurationMemberOAuth2ClientCredentialConfiguration{},
+	),
+}
+
+func findPluginByTwoPartKey(ctx context.Context, conn *qbusiness.Client, applicationID, pluginID string) (*qbusiness.GetPluginOutput, error) {
+	input := &qbusiness.GetPluginInput{
+		ApplicationId: aws.String(applicationID),
+		PluginId:      aws.String(pluginID),
+	}
+
+	output, err := conn.GetPlugin(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusPlugin(ctx context.Context, conn *qbusiness.Client, applicationID, pluginID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findPluginByTwoPartKey(ctx, conn, applicationID, pluginID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.BuildStatus), nil
+	}
+}
+
+func waitPluginCreated(ctx context.Context, conn *qbusiness.Client, applicationID, pluginID string, timeout time.Duration) (*qbusiness.GetPluginOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.PluginBuildStatusCreateInProgress),
+		Target:  enum.Slice(awstypes.PluginBuildStatusReady),
+		Refresh: statusPlugin(ctx, conn, applicationID, pluginID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetPluginOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPluginUpdated(ctx context.Context, conn *qbusiness.Client, applicationID, pluginID string, timeout time.Duration) (*qbusiness.GetPluginOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.PluginBuildStatusUpdateInProgress),
+		Target:  enum.Slice(awstypes.PluginBuildStatusReady),
+		Refresh: statusPlugin(ctx, conn, applicationID, pluginID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetPluginOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPluginDeleted(ctx context.Context, conn *qbusiness.Client, applicationID, pluginID string, timeout time.Duration) (*qbusiness.GetPluginOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.PluginBuildStatusReady, awstypes.PluginBuildStatusDeleteInProgress),
+		Target:  []string{},
+		Refresh: statusPlugin(ctx, conn, applicationID, pluginID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetPluginOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type pluginResourceModel struct {
+	ApplicationID             types.String                                                    `tfsdk:"application_id"`
+	AuthConfiguration         fwtypes.ListNestedObjectValueOf[pluginAuthConfigurationModel]   `tfsdk:"auth_configuration"`
+	BuildStatus               fwtypes.StringEnum[awstypes.PluginBuildStatus]                  `tfsdk:"build_status"`
+	CustomPluginConfiguration fwtypes.ListNestedObjectValueOf[customPluginConfigurationModel] `tfsdk:"custom_plugin_configuration"`
+	DisplayName               types.String                                                    `tfsdk:"display_name"`
+	ID                        types.String                                                    `tfsdk:"id"`
+	PluginARN                 types.String                                                    `tfsdk:"arn"`
+	PluginID                  types.String                                                    `tfsdk:"plugin_id"`
+	ServerURL                 types.String                                                    `tfsdk:"server_url"`
+	State                     fwtypes.StringEnum[awstypes.PluginState]                        `tfsdk:"state"`
+	Tags                      tftags.Map                                                      `tfsdk:"tags"`
+	TagsAll                   tftags.Map                                                      `tfsdk:"tags_all"`
+	Timeouts                  timeouts.Value                                                  `tfsdk:"timeouts"`
+	Type                      fwtypes.StringEnum[awstypes.PluginType]                         `tfsdk:"type"`
+}
+
+// pluginAuthConfigurationModel models the awstypes.PluginAuthConfiguration union.
+type pluginAuthConfigurationModel struct {
+	BasicAuthConfiguration              fwtypes.ListNestedObjectValueOf[pluginSecretAuthConfigurationModel] `tfsdk:"basic_auth_configuration"`
+	NoAuthConfiguration                 fwtypes.ListNestedObjectValueOf[pluginNoAuthConfigurationModel]     `tfsdk:"no_auth_configuration"`
+	OAuth2ClientCredentialConfiguration fwtypes.ListNestedObjectValueOf[pluginSecretAuthConfigurationModel] `tfsdk:"oauth2_client_credential_configuration"`
+}
+
+type pluginSecretAuthConfigurationModel struct {
+	RoleARN   fwtypes.ARN `tfsdk:"role_arn"`
+	SecretARN fwtypes.ARN `tfsdk:"secret_arn"`
+}
+
+type pluginNoAuthConfigurationModel struct{}
+
+type customPluginConfigurationModel struct {
+	APISchema     fwtypes.ListNestedObjectValueOf[apiSchemaModel] `tfsdk:"api_schema"`
+	APISchemaType fwtypes.StringEnum[awstypes.APISchemaType]      `tfsdk:"api_schema_type"`
+	Description   types.String                                    `tfsdk:"description"`
+}
+
+// apiSchemaModel models the awstypes.APISchema union.
+type apiSchemaModel struct {
+	Payload types.String                             `tfsdk:"payload"`
+	S3      fwtypes.ListNestedObjectValueOf[s3Model] `tfsdk:"s3"`
+}
+
+type s3Model struct {
+	Bucket types.String `tfsdk:"bucket"`
+	Key    types.String `tfsdk:"key"`
+}
+
+const (
+	pluginResourceIDPartCount = 2
+)
+
+func (m *pluginResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(m.ID.ValueString(), pluginResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	m.ApplicationID = types.StringValue(parts[0])
+	m.PluginID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (m *pluginResourceModel) setID() (string, error) {
+	parts := []string{
+		m.ApplicationID.ValueString(),
+		m.PluginID.ValueString(),
+	}
+
+	return flex.FlattenResourceId(parts, pluginResourceIDPartCount, false)
+}
@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfqbusiness "github.com/hashicorp/terraform-provider-aws/internal/service/qbusiness"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccQBusinessPlugin_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var plugin qbusiness.GetPluginOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_qbusiness_plugin.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QBusinessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPluginDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPluginConfig_basic(rName, "ENABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPluginExists(ctx, resourceName, &plugin),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "auth_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auth_configuration.0.no_auth_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "build_status", "READY"),
+					resource.TestCheckResourceAttr(resourceName, "custom_plugin_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "custom_plugin_configuration.0.api_schema_type", "OPEN_API_V3"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDisplayName, rName),
+					resource.TestCheckResourceAttrSet(resourceName, "plugin_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "CUSTOM"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPluginConfig_basic(rName, "DISABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPluginExists(ctx, resourceName, &plugin),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQBusinessPlugin_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var plugin qbusiness.GetPluginOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_qbusiness_plugin.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QBusinessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPluginDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPluginConfig_basic(rName, "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPluginExists(ctx, resourceName, &plugin),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfqbusiness.ResourcePlugin, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPluginDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QBusinessClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_qbusiness_plugin" {
+				continue
+			}
+
+			_, err := tfqbusiness.FindPluginByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrApplicationID], rs.Primary.Attributes["plugin_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Amazon Q Business Plugin %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPluginExists(ctx context.Context, n string, v *qbusiness.GetPluginOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QBusinessClient(ctx)
+
+		output, err := tfqbusiness.FindPluginByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrApplicationID], rs.Primary.Attributes["plugin_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPluginConfig_basic(rName, state string) string {
+	return acctest.ConfigCompose(testAccApplicationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_qbusiness_plugin" "test" {
+  application_id = aws_qbusiness_application.test.id
+  display_name   = %[1]q
+  server_url     = "https://example.com"
+  state          = %[2]q
+  type           = "CUSTOM"
+
+  auth_configuration {
+    no_auth_configuration {}
+  }
+
+  custom_plugin_configuration {
+    api_schema_type = "OPEN_API_V3"
+    description     = %[1]q
+
+    api_schema {
+      payload = jsonencode({
+        openapi = "3.0.0"
+        info = {
+          title   = %[1]q
+          version = "1.0.0"
+        }
+        paths = {
+          "/test" = {
+            get = {
+              operationId = "getTest"
+              summary     = "Get test"
+              responses = {
+                "200" = {
+                  description = "OK"
+                }
+              }
+            }
+          }
+        }
+      })
+    }
+  }
+}
+`, rName, state))
+}
@@ -0,0 +1,422 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/qbusiness/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_qbusiness_retriever", name="Retriever")
+// @Tags(identifierAttribute="arn")
+func newRetrieverResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &retrieverResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type retrieverResource struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+	framework.WithImportByID
+}
+
+func (*retrieverResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_qbusiness_retriever"
+}
+
+func (r *retrieverResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	indexConfigurationBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[retrieverIndexConfigurationModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"index_id": schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrApplicationID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDisplayName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1000),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"retriever_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.RetrieverType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrConfiguration: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[retrieverConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"kendra_index_configuration": indexConfigurationBlock(),
+						"native_index_configuration": indexConfigurationBlock(),
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *retrieverResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data retrieverResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	input := &qbusiness.CreateRetrieverInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input, retrieverFlexOptions...)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateRetriever(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Amazon Q Business Retriever (%s)", data.DisplayName.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.RetrieverARN = fwflex.StringToFramework(ctx, output.RetrieverArn)
+	data.RetrieverID = fwflex.StringToFramework(ctx, output.RetrieverId)
+	id, err := data.setID()
+	if err != nil {
+		response.Diagnostics.AddError("flattening resource ID Amazon Q Business Retriever", err.Error())
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	if _, err := waitRetrieverCreated(ctx, conn, data.ApplicationID.ValueString(), data.RetrieverID.ValueString(), r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Amazon Q Business Retriever (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *retrieverResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data retrieverResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	output, err := findRetrieverByTwoPartKey(ctx, conn, data.ApplicationID.ValueString(), data.RetrieverID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Amazon Q Business Retriever (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, retrieverFlexOptions...)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *retrieverResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new retrieverResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	diff, d := fwflex.Calculate(ctx, new, old)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		input := &qbusiness.UpdateRetrieverInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input, retrieverFlexOptions...)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateRetriever(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Amazon Q Business Retriever (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *retrieverResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data retrieverResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	_, err := conn.DeleteRetriever(ctx, &qbusiness.DeleteRetrieverInput{
+		ApplicationId: data.ApplicationID.ValueStringPointer(),
+		RetrieverId:   data.RetrieverID.ValueStringPointer(),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Amazon Q Business Retriever (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitRetrieverDeleted(ctx, conn, data.ApplicationID.ValueString(), data.RetrieverID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Amazon Q Business Retriever (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *retrieverResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+var retrieverFlexOptions = []fwflex.AutoFlexOptionsFunc{
+	fwflex.WithUnionMembers(
+		&awstypes.RetrieverConfigurationMemberKendraIndexConfiguration{},
+		&awstypes.RetrieverConfigurationMemberNativeIndexConfiguration{},
+	),
+}
+
+func findRetrieverByTwoPartKey(ctx context.Context, conn *qbusiness.Client, applicationID, retrieverID string) (*qbusiness.GetRetrieverOutput, error) {
+	input := &qbusiness.GetRetrieverInput{
+		ApplicationId: aws.String(applicationID),
+		RetrieverId:   aws.String(retrieverID),
+	}
+
+	output, err := conn.GetRetriever(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusRetriever(ctx context.Context, conn *qbusiness.Client, applicationID, retrieverID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findRetrieverByTwoPartKey(ctx, conn, applicationID, retrieverID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitRetrieverCreated(ctx context.Context, conn *qbusiness.Client, applicationID, retrieverID string, timeout time.Duration) (*qbusiness.GetRetrieverOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.RetrieverStatusCreating),
+		Target:  enum.Slice(awstypes.RetrieverStatusActive),
+		Refresh: statusRetriever(ctx, conn, applicationID, retrieverID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetRetrieverOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitRetrieverDeleted(ctx context.Context, conn *qbusiness.Client, applicationID, retrieverID string, timeout time.Duration) (*qbusiness.GetRetrieverOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.RetrieverStatusActive, awstypes.RetrieverStatusFailed),
+		Target:  []string{},
+		Refresh: statusRetriever(ctx, conn, applicationID, retrieverID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetRetrieverOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type retrieverResourceModel struct {
+	ApplicationID types.String                                                 `tfsdk:"application_id"`
+	Configuration fwtypes.ListNestedObjectValueOf[retrieverConfigurationModel] `tfsdk:"configuration"`
+	DisplayName   types.String                                                 `tfsdk:"display_name"`
+	ID            types.String                                                 `tfsdk:"id"`
+	RetrieverARN  types.String                                                 `tfsdk:"arn"`
+	RetrieverID   types.String                                                 `tfsdk:"retriever_id"`
+	RoleARN       fwtypes.ARN                                                  `tfsdk:"role_arn"`
+	Tags          tftags.Map                                                   `tfsdk:"tags"`
+	TagsAll       tftags.Map                                                   `tfsdk:"tags_all"`
+	Timeouts      timeouts.Value                                               `tfsdk:"timeouts"`
+	Type          fwtypes.StringEnum[awstypes.RetrieverType]                   `tfsdk:"type"`
+}
+
+// retrieverConfigurationModel models the awstypes.RetrieverConfiguration union.
+type retrieverConfigurationModel struct {
+	KendraIndexConfiguration fwtypes.ListNestedObjectValueOf[retrieverIndexConfigurationModel] `tfsdk:"kendra_index_configuration"`
+	NativeIndexConfiguration fwtypes.ListNestedObjectValueOf[retrieverIndexConfigurationModel] `tfsdk:"native_index_configuration"`
+}
+
+type retrieverIndexConfigurationModel struct {
+	IndexID types.String `tfsdk:"index_id"`
+}
+
+const (
+	retrieverResourceIDPartCount = 2
+)
+
+func (m *retrieverResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(m.ID.ValueString(), retrieverResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	m.ApplicationID = types.StringValue(parts[0])
+	m.RetrieverID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (m *retrieverResourceModel) setID() (string, error) {
+	parts := []string{
+		m.ApplicationID.ValueString(),
+		m.RetrieverID.ValueString(),
+	}
+
+	return flex.FlattenResourceId(parts, retrieverResourceIDPartCount, false)
+}
@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_qbusiness_application", sweepApplications,
+		"aws_qbusiness_index",
+		"aws_qbusiness_plugin",
+		"aws_qbusiness_retriever",
+		"aws_qbusiness_web_experience",
+	)
+	awsv2.Register("aws_qbusiness_data_source", sweepDataSources)
+	awsv2.Register("aws_qbusiness_index", sweepIndices, "aws_qbusiness_data_source", "aws_qbusiness_retriever")
+	awsv2.Register("aws_qbusiness_plugin", sweepPlugins)
+	awsv2.Register("aws_qbusiness_retriever", sweepRetrievers)
+	awsv2.Register("aws_qbusiness_web_experience", sweepWebExperiences)
+}
+
+func sweepApplications(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.QBusinessClient(ctx)
+	input := &qbusiness.ListApplicationsInput{}
+	var sweepResources []sweep.Sweepable
+
+	pages := qbusiness.NewListApplicationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Applications {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newApplicationResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.ApplicationId))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepDataSources(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.QBusinessClient(ctx)
+
+	return sweepApplicationChildren(ctx, client, func(applicationID string) ([]sweep.Sweepable, error) {
+		input := &qbusiness.ListIndicesInput{
+			ApplicationId: aws.String(applicationID),
+		}
+		var sweepResources []sweep.Sweepable
+
+		pages := qbusiness.NewListIndicesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, v := range page.Indices {
+				indexID := aws.ToString(v.IndexId)
+				input := &qbusiness.ListDataSourcesInput{
+					ApplicationId: aws.String(applicationID),
+					IndexId:       aws.String(indexID),
+				}
+
+				pages := qbusiness.NewListDataSourcesPaginator(conn, input)
+				for pages.HasMorePages() {
+					page, err := pages.NextPage(ctx)
+
+					if err != nil {
+						return nil, err
+					}
+
+					for _, v := range page.DataSources {
+						sweepResources = append(sweepResources, framework.NewSweepResource(newDataSourceResource, client,
+							framework.NewAttribute(names.AttrApplicationID, applicationID),
+							framework.NewAttribute("index_id", indexID),
+							framework.NewAttribute("data_source_id", aws.ToString(v.DataSourceId))))
+					}
+				}
+			}
+		}
+
+		return sweepResources, nil
+	})
+}
+
+func sweepIndices(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.QBusinessClient(ctx)
+
+	return sweepApplicationChildren(ctx, client, func(applicationID string) ([]sweep.Sweepable, error) {
+		input := &qbusiness.ListIndicesInput{
+			ApplicationId: aws.String(applicationID),
+		}
+		var sweepResources []sweep.Sweepable
+
+		pages := qbusiness.NewListIndicesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, v := range page.Indices {
+				sweepResources = append(sweepResources, framework.NewSweepResource(newIndexResource, client,
+					framework.NewAttribute(names.AttrApplicationID, applicationID),
+					framework.NewAttribute("index_id", aws.ToString(v.IndexId))))
+			}
+		}
+
+		return sweepResources, nil
+	})
+}
+
+func sweepPlugins(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.QBusinessClient(ctx)
+
+	return sweepApplicationChildren(ctx, client, func(applicationID string) ([]sweep.Sweepable, error) {
+		input := &qbusiness.ListPluginsInput{
+			ApplicationId: aws.String(applicationID),
+		}
+		var sweepResources []sweep.Sweepable
+
+		pages := qbusiness.NewListPluginsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, v := range page.Plugins {
+				sweepResources = append(sweepResources, framework.NewSweepResource(newPluginResource, client,
+					framework.NewAttribute(names.AttrApplicationID, applicationID),
+					framework.NewAttribute("plugin_id", aws.ToString(v.PluginId))))
+			}
+		}
+
+		return sweepResources, nil
+	})
+}
+
+func sweepRetrievers(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.QBusinessClient(ctx)
+
+	return sweepApplicationChildren(ctx, client, func(applicationID string) ([]sweep.Sweepable, error) {
+		input := &qbusiness.ListRetrieversInput{
+			ApplicationId: aws.String(applicationID),
+		}
+		var sweepResources []sweep.Sweepable
+
+		pages := qbusiness.NewListRetrieversPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, v := range page.Retrievers {
+				sweepResources = append(sweepResources, framework.NewSweepResource(newRetrieverResource, client,
+					framework.NewAttribute(names.AttrApplicationID, applicationID),
+					framework.NewAttribute("retriever_id", aws.ToString(v.RetrieverId))))
+			}
+		}
+
+		return sweepResources, nil
+	})
+}
+
+func sweepWebExperiences(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.QBusinessClient(ctx)
+
+	return sweepApplicationChildren(ctx, client, func(applicationID string) ([]sweep.Sweepable, error) {
+		input := &qbusiness.ListWebExperiencesInput{
+			ApplicationId: aws.String(applicationID),
+		}
+		var sweepResources []sweep.Sweepable
+
+		pages := qbusiness.NewListWebExperiencesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, v := range page.WebExperiences {
+				sweepResources = append(sweepResources, framework.NewSweepResource(newWebExperienceResource, client,
+					framework.NewAttribute(names.AttrApplicationID, applicationID),
+					framework.NewAttribute("web_experience_id", aws.ToString(v.WebExperienceId))))
+			}
+		}
+
+		return sweepResources, nil
+	})
+}
+
+// sweepApplicationChildren calls f for each Amazon Q Business application.
+func sweepApplicationChildren(ctx context.Context, client *conns.AWSClient, f func(string) ([]sweep.Sweepable, error)) ([]sweep.Sweepable, error) {
+	conn := client.QBusinessClient(ctx)
+	input := &qbusiness.ListApplicationsInput{}
+	var sweepResources []sweep.Sweepable
+
+	pages := qbusiness.NewListApplicationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Applications {
+			v, err := f(aws.ToString(v.ApplicationId))
+
+			if err != nil {
+				return nil, err
+			}
+
+			sweepResources = append(sweepResources, v...)
+		}
+	}
+
+	return sweepResources, nil
+}
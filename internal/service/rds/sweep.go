@@ -20,7 +20,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_rds_cluster_parameter_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_rds_cluster_parameter_group", &resource.Sweeper{
 		Name: "aws_rds_cluster_parameter_group",
 		F:    sweepClusterParameterGroups,
 		Dependencies: []string{
@@ -28,7 +28,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_cluster_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_cluster_snapshot", &resource.Sweeper{
 		Name: "aws_db_cluster_snapshot",
 		F:    sweepClusterSnapshots,
 		Dependencies: []string{
@@ -36,7 +36,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_rds_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_rds_cluster", &resource.Sweeper{
 		Name: "aws_rds_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -44,17 +44,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_event_subscription", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_event_subscription", &resource.Sweeper{
 		Name: "aws_db_event_subscription",
 		F:    sweepEventSubscriptions,
 	})
 
-	resource.AddTestSweepers("aws_rds_global_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_rds_global_cluster", &resource.Sweeper{
 		Name: "aws_rds_global_cluster",
 		F:    sweepGlobalClusters,
 	})
 
-	resource.AddTestSweepers("aws_db_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_instance", &resource.Sweeper{
 		Name: "aws_db_instance",
 		F:    sweepInstances,
 		Dependencies: []string{
@@ -62,7 +62,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_option_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_option_group", &resource.Sweeper{
 		Name: "aws_db_option_group",
 		F:    sweepOptionGroups,
 		Dependencies: []string{
@@ -71,7 +71,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_parameter_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_parameter_group", &resource.Sweeper{
 		Name: "aws_db_parameter_group",
 		F:    sweepParameterGroups,
 		Dependencies: []string{
@@ -79,12 +79,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_proxy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_proxy", &resource.Sweeper{
 		Name: "aws_db_proxy",
 		F:    sweepProxies,
 	})
 
-	resource.AddTestSweepers("aws_db_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_snapshot", &resource.Sweeper{
 		Name: "aws_db_snapshot",
 		F:    sweepSnapshots,
 		Dependencies: []string{
@@ -92,7 +92,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_subnet_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_subnet_group", &resource.Sweeper{
 		Name: "aws_db_subnet_group",
 		F:    sweepSubnetGroups,
 		Dependencies: []string{
@@ -100,7 +100,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_instance_automated_backups_replication", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_instance_automated_backups_replication", &resource.Sweeper{
 		Name: "aws_db_instance_automated_backups_replication",
 		F:    sweepInstanceAutomatedBackups,
 		Dependencies: []string{
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build !generate
+// +build !generate
+
+package resourcegroupstaggingapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Custom Resource Groups Tagging API tag service functions using the same format as generated code.
+// These are used to tag resources whose own service package has no tag functions when the provider is
+// configured with `resource_tagging_api_fallback`.
+
+// listTags lists the tags of any AWS resource.
+// The identifier is the resource ARN.
+func listTags(ctx context.Context, conn *resourcegroupstaggingapi.Client, identifier string, optFns ...func(*resourcegroupstaggingapi.Options)) (tftags.KeyValueTags, error) {
+	input := &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceARNList: []string{identifier},
+	}
+
+	output, err := conn.GetResources(ctx, input, optFns...)
+
+	if err != nil {
+		return tftags.New(ctx, nil), err
+	}
+
+	for _, v := range output.ResourceTagMappingList {
+		if aws.ToString(v.ResourceARN) == identifier {
+			return KeyValueTags(ctx, v.Tags), nil
+		}
+	}
+
+	return tftags.New(ctx, nil), nil
+}
+
+// ListTags lists the tags of any AWS resource and sets them in Context.
+// It is called from outside this package.
+func (p *servicePackage) ListTags(ctx context.Context, meta any, identifier string) error {
+	tags, err := listTags(ctx, meta.(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx), identifier)
+
+	if err != nil {
+		return err
+	}
+
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		inContext.TagsOut = option.Some(tags)
+	}
+
+	return nil
+}
+
+// updateTags updates the tags of any AWS resource.
+// The identifier is the resource ARN.
+func updateTags(ctx context.Context, conn *resourcegroupstaggingapi.Client, identifier string, oldTagsMap, newTagsMap any, optFns ...func(*resourcegroupstaggingapi.Options)) error {
+	oldTags := tftags.New(ctx, oldTagsMap)
+	newTags := tftags.New(ctx, newTagsMap)
+
+	removedTags := oldTags.Removed(newTags)
+	removedTags = removedTags.IgnoreSystem(names.ResourceGroupsTaggingAPI)
+	if len(removedTags) > 0 {
+		input := &resourcegroupstaggingapi.UntagResourcesInput{
+			ResourceARNList: []string{identifier},
+			TagKeys:         removedTags.Keys(),
+		}
+
+		output, err := conn.UntagResources(ctx, input, optFns...)
+
+		if err == nil {
+			err = failedResourcesError(output.FailedResourcesMap)
+		}
+
+		if err != nil {
+			return fmt.Errorf("untagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	updatedTags := oldTags.Updated(newTags)
+	updatedTags = updatedTags.IgnoreSystem(names.ResourceGroupsTaggingAPI)
+	if len(updatedTags) > 0 {
+		input := &resourcegroupstaggingapi.TagResourcesInput{
+			ResourceARNList: []string{identifier},
+			Tags:            updatedTags.Map(),
+		}
+
+		output, err := conn.TagResources(ctx, input, optFns...)
+
+		if err == nil {
+			err = failedResourcesError(output.FailedResourcesMap)
+		}
+
+		if err != nil {
+			return fmt.Errorf("tagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// UpdateTags updates the tags of any AWS resource.
+// It is called from outside this package.
+func (p *servicePackage) UpdateTags(ctx context.Context, meta any, identifier string, oldTags, newTags any) error {
+	return updateTags(ctx, meta.(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx), identifier, oldTags, newTags)
+}
+
+// failedResourcesError returns an error for each resource that the Resource Groups Tagging API failed to tag or untag.
+// These failures are reported in the operation's output rather than as an API error.
+func failedResourcesError(apiObjects map[string]awstypes.FailureInfo) error {
+	var errs []error
+
+	for _, k := range slices.Sorted(maps.Keys(apiObjects)) {
+		v := apiObjects[k]
+		errs = append(errs, fmt.Errorf("%s: %s: %s", k, v.ErrorCode, aws.ToString(v.ErrorMessage)))
+	}
+
+	return errors.Join(errs...)
+}
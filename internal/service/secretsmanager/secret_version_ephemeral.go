@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource(name="Secret Version")
+func newEphemeralSecretVersion(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralSecretVersion{}, nil
+}
+
+type ephemeralSecretVersion struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (*ephemeralSecretVersion) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_secretsmanager_secret_version"
+}
+
+func (e *ephemeralSecretVersion) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrCreatedDate: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"secret_id": schema.StringAttribute{
+				Required: true,
+			},
+			"secret_binary": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"secret_string": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"version_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("version_stage")),
+				},
+			},
+			"version_stage": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"version_stages": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralSecretVersion) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data ephemeralSecretVersionModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().SecretsManagerClient(ctx)
+
+	secretID := data.SecretID.ValueString()
+	var version string
+	input := &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(secretID),
+	}
+
+	if !data.VersionID.IsNull() {
+		version = data.VersionID.ValueString()
+		input.VersionId = aws.String(version)
+	} else {
+		version = secretVersionStageCurrent
+		if !data.VersionStage.IsNull() {
+			version = data.VersionStage.ValueString()
+		}
+		input.VersionStage = aws.String(version)
+		data.VersionStage = types.StringValue(version)
+	}
+
+	id := secretVersionCreateResourceID(secretID, version)
+	output, err := findSecretVersion(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Secrets Manager Secret Version (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.SecretBinary = fwflex.StringValueToFramework(ctx, string(output.SecretBinary))
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type ephemeralSecretVersionModel struct {
+	ARN           types.String                      `tfsdk:"arn"`
+	CreatedDate   timetypes.RFC3339                 `tfsdk:"created_date"`
+	SecretBinary  types.String                      `tfsdk:"secret_binary"`
+	SecretID      types.String                      `tfsdk:"secret_id"`
+	SecretString  types.String                      `tfsdk:"secret_string"`
+	VersionID     types.String                      `tfsdk:"version_id"`
+	VersionStage  types.String                      `tfsdk:"version_stage"`
+	VersionStages fwtypes.ListValueOf[types.String] `tfsdk:"version_stages"`
+}
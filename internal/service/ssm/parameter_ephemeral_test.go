@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMParameterEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"echo": echoprovider.NewProviderServer(),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterEphemeralConfig_basic(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey(names.AttrName), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey(names.AttrType), knownvalue.StringExact("SecureString")),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey(names.AttrValue), knownvalue.StringExact("TestValue")),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey(names.AttrVersion), knownvalue.Int64Exact(1)),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("with_decryption"), knownvalue.Bool(true)),
+				},
+			},
+		},
+	})
+}
+
+func testAccParameterEphemeralConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  name  = %[1]q
+  type  = "SecureString"
+  value = "TestValue"
+}
+
+ephemeral "aws_ssm_parameter" "test" {
+  name = aws_ssm_parameter.test.name
+}
+
+provider "echo" {
+  data = ephemeral.aws_ssm_parameter.test
+}
+
+resource "echo" "test" {}
+`, rName)
+}
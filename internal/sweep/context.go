@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/describe"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/log"
 )
 
@@ -17,5 +18,16 @@ func Context(region string) context.Context {
 
 	ctx = log.Logger(ctx, "sweeper", region)
 
+	ctx = describe.WithRegion(ctx, region)
+
+	return ctx
+}
+
+// WithResourceType returns a new Context that records the resource type being swept.
+func WithResourceType(ctx context.Context, resourceType string) context.Context {
+	ctx = log.WithResourceType(ctx, resourceType)
+
+	ctx = describe.WithResourceType(ctx, resourceType)
+
 	return ctx
 }
@@ -5,21 +5,29 @@ package framework
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"strings"
 	"time"
 
 	awsretry "github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/describe"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type attribute struct {
@@ -49,33 +57,12 @@ func NewSweepResource(factory func(context.Context) (fwresource.ResourceWithConf
 }
 
 func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
-	resource, err := sr.factory(ctx)
+	ctx, resource, state, err := sr.initialize(ctx)
 
 	if err != nil {
 		return err
 	}
 
-	metadata := resourceMetadata(ctx, resource)
-	ctx = tflog.SetField(ctx, "resource_type", metadata.TypeName)
-
-	resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: sr.meta}, &fwresource.ConfigureResponse{})
-
-	schemaResp := fwresource.SchemaResponse{}
-	resource.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
-
-	state := tfsdk.State{
-		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
-		Schema: schemaResp.Schema,
-	}
-
-	for _, attr := range sr.attributes {
-		d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
-		if d.HasError() {
-			return fwdiag.DiagnosticsError(d)
-		}
-		ctx = tflog.SetField(ctx, attr.path, attr.value)
-	}
-
 	tflog.Info(ctx, "Sweeping resource")
 
 	jitter := time.Duration(rand.Int63n(int64(1*time.Second))) - 1*time.Second/2
@@ -118,6 +105,113 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// Describe reads the resource and returns its description.
+func (sr *sweepResource) Describe(ctx context.Context) (*describe.ResourceDescription, error) {
+	ctx, resource, state, err := sr.initialize(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = tftags.NewContext(ctx, nil, nil)
+
+	response := fwresource.ReadResponse{State: state}
+	resource.Read(ctx, fwresource.ReadRequest{State: state}, &response)
+
+	if err := fwdiag.DiagnosticsError(response.Diagnostics); err != nil {
+		return nil, err
+	}
+
+	if response.State.Raw.IsNull() {
+		return nil, nil
+	}
+
+	attribute := func(name string) string {
+		var v attr.Value
+		if diags := response.State.GetAttribute(ctx, path.Root(name), &v); diags.HasError() {
+			return ""
+		}
+		if v, ok := v.(basetypes.StringValuable); ok {
+			v, _ := v.ToStringValue(ctx)
+			return v.ValueString()
+		}
+		return ""
+	}
+
+	description := &describe.ResourceDescription{
+		Type: resourceMetadata(ctx, resource).TypeName,
+		ID:   attribute(names.AttrID),
+	}
+
+	if description.ID == "" {
+		parts := make([]string, 0, len(sr.attributes))
+		for _, v := range sr.attributes {
+			parts = append(parts, fmt.Sprint(v.value))
+		}
+		description.ID = strings.Join(parts, flex.ResourceIdSeparator)
+	}
+
+	description.Name = attribute(names.AttrName)
+	if description.Name == "" {
+		description.Name = description.ID
+	}
+
+	if inContext, ok := tftags.FromContext(ctx); ok && inContext.TagsOut.IsSome() {
+		description.Tags = inContext.TagsOut.UnwrapOrDefault().Map()
+	} else {
+		var v attr.Value
+		if diags := response.State.GetAttribute(ctx, path.Root(names.AttrTags), &v); !diags.HasError() {
+			if v, ok := v.(basetypes.MapValuable); ok {
+				description.Tags = fwflex.ExpandFrameworkStringValueMap(ctx, v)
+			}
+		}
+
+		if len(description.Tags) == 0 {
+			tags, err := describe.ResourceTags(ctx, sr.meta, description.Type, attribute)
+
+			if err != nil {
+				return nil, fmt.Errorf("listing tags: %w", err)
+			}
+
+			description.Tags = tags
+		}
+	}
+
+	return description, nil
+}
+
+// initialize returns a configured resource and a state containing the sweeper's attributes.
+func (sr *sweepResource) initialize(ctx context.Context) (context.Context, fwresource.ResourceWithConfigure, tfsdk.State, error) {
+	resource, err := sr.factory(ctx)
+
+	if err != nil {
+		return ctx, nil, tfsdk.State{}, err
+	}
+
+	metadata := resourceMetadata(ctx, resource)
+	ctx = tflog.SetField(ctx, "resource_type", metadata.TypeName)
+
+	resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: sr.meta}, &fwresource.ConfigureResponse{})
+
+	schemaResp := fwresource.SchemaResponse{}
+	resource.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		Schema: schemaResp.Schema,
+	}
+
+	for _, attr := range sr.attributes {
+		d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
+		if d.HasError() {
+			return ctx, nil, tfsdk.State{}, fwdiag.DiagnosticsError(d)
+		}
+		ctx = tflog.SetField(ctx, attr.path, attr.value)
+	}
+
+	return ctx, resource, state, nil
+}
+
 func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) error {
 	var response fwresource.DeleteResponse
 	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
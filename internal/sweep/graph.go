@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// dependencyGraph returns a graph of the specified sweepers in which each sweeper depends on
+// the sweepers that must be run before it.
+//
+// Edges come from each sweeper's declared Dependencies and from the specified schema references.
+// A schema reference from resource type A to resource type B (A has an attribute holding B's ID)
+// means that B's sweeper depends on A's, as instances of A must be deleted before instances of B.
+//
+// An error is returned if the declared dependencies contain a cycle.
+// Schema references which would introduce a cycle are ignored.
+func dependencyGraph(ctx context.Context, sweepers map[string]*resource.Sweeper, references map[string][]string) (*depgraph.Graph, error) {
+	g := depgraph.New()
+
+	sweeperNames := tfmaps.Keys(sweepers)
+	slices.Sort(sweeperNames)
+
+	for _, name := range sweeperNames {
+		g.AddNode(name)
+	}
+
+	for _, name := range sweeperNames {
+		for _, dependency := range sweepers[name].Dependencies {
+			if !g.HasNode(dependency) {
+				tflog.Warn(ctx, "Sweeper has dependency, but that sweeper was not found", map[string]any{
+					"sweeper":    name,
+					"dependency": dependency,
+				})
+
+				continue
+			}
+
+			if err := g.AddDependency(name, dependency); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if _, err := g.OverallOrder(); err != nil {
+		return nil, err
+	}
+
+	for _, from := range sweeperNames {
+		for _, to := range references[from] {
+			if from == to || !g.HasNode(to) {
+				continue
+			}
+
+			// 'to' must be swept after 'from'.
+			dependencies, err := g.DependenciesOf(from)
+			if err != nil {
+				return nil, err
+			}
+
+			if slices.Contains(dependencies, to) {
+				tflog.Debug(ctx, "Ignoring schema reference that would introduce a dependency cycle", map[string]any{
+					"from": from,
+					"to":   to,
+				})
+
+				continue
+			}
+
+			if err := g.AddDependency(to, from); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return g, nil
+}
+
+// schemaReferences returns, for each resource type implemented by the specified service packages,
+// the resource types referenced by its top-level attributes.
+// Only resource types in knownTypes are considered.
+func schemaReferences(ctx context.Context, servicePackages []conns.ServicePackage, knownTypes []string) map[string][]string {
+	references := make(map[string][]string)
+
+	for _, sp := range servicePackages {
+		for _, v := range sp.SDKResources(ctx) {
+			if !slices.Contains(knownTypes, v.TypeName) {
+				continue
+			}
+
+			r := v.Factory()
+			for attributeName := range r.SchemaMap() {
+				if referenced, ok := referencedType(v.TypeName, attributeName, knownTypes); ok {
+					references[v.TypeName] = append(references[v.TypeName], referenced)
+				}
+			}
+		}
+
+		for _, v := range sp.FrameworkResources(ctx) {
+			r, err := v.Factory(ctx)
+			if err != nil {
+				continue
+			}
+
+			var metadataResponse fwresource.MetadataResponse
+			r.Metadata(ctx, fwresource.MetadataRequest{ProviderTypeName: "aws"}, &metadataResponse)
+			typeName := metadataResponse.TypeName
+
+			if !slices.Contains(knownTypes, typeName) {
+				continue
+			}
+
+			var schemaResponse fwresource.SchemaResponse
+			r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResponse)
+			if schemaResponse.Diagnostics.HasError() {
+				continue
+			}
+
+			for attributeName := range schemaResponse.Schema.Attributes {
+				if referenced, ok := referencedType(typeName, attributeName, knownTypes); ok {
+					references[typeName] = append(references[typeName], referenced)
+				}
+			}
+		}
+	}
+
+	for k, v := range references {
+		slices.Sort(v)
+		references[k] = slices.Compact(v)
+	}
+
+	return references
+}
+
+// referencedType returns the resource type, if any, whose identifier is held by the specified attribute.
+// An attribute named "<noun>_id", "<noun>_ids", "<noun>_arn" or "<noun>_arns" of resource type
+// "aws_<p1>_<p2>_..." references the first of "aws_<p1>_<p2>_<noun>" and "aws_<p1>_<noun>"
+// which is in knownTypes.
+// There is no fallback to "aws_<noun>", as that would match unrelated resource types; such references
+// must be declared in the sweeper's Dependencies.
+// For example, aws_ec2_transit_gateway_vpc_attachment's "transit_gateway_id" attribute references aws_ec2_transit_gateway.
+func referencedType(typeName, attributeName string, knownTypes []string) (string, bool) {
+	if attributeName == names.AttrID || attributeName == names.AttrARN {
+		return "", false
+	}
+
+	var noun string
+	for _, suffix := range []string{"_ids", "_id", "_arns", "_arn"} {
+		if v, ok := strings.CutSuffix(attributeName, suffix); ok {
+			noun = v
+			break
+		}
+	}
+
+	if noun == "" {
+		return "", false
+	}
+
+	parts := strings.Split(typeName, "_")
+	for i := len(parts) - 1; i >= 2; i-- {
+		candidate := strings.Join(append(slices.Clone(parts[:i]), noun), "_")
+
+		if candidate != typeName && slices.Contains(knownTypes, candidate) {
+			return candidate, true
+		}
+	}
+
+	return "", false
+}
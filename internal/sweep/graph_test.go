@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestReferencedType(t *testing.T) {
+	t.Parallel()
+
+	knownTypes := []string{
+		"aws_ec2_transit_gateway",
+		"aws_ec2_transit_gateway_vpc_attachment",
+		"aws_security_group",
+		"aws_subnet",
+		"aws_vpc",
+	}
+
+	testCases := map[string]struct {
+		typeName      string
+		attributeName string
+		expected      string
+		expectedOK    bool
+	}{
+		"no global fallback": {
+			typeName:      "aws_subnet",
+			attributeName: "vpc_id",
+		},
+		"service prefix": {
+			typeName:      "aws_ec2_transit_gateway_vpc_attachment",
+			attributeName: "transit_gateway_id",
+			expected:      "aws_ec2_transit_gateway",
+			expectedOK:    true,
+		},
+		"plural": {
+			typeName:      "aws_ec2_transit_gateway_vpc_attachment",
+			attributeName: "transit_gateway_ids",
+			expected:      "aws_ec2_transit_gateway",
+			expectedOK:    true,
+		},
+		"unrelated id": {
+			typeName:      "aws_ec2_transit_gateway_vpc_attachment",
+			attributeName: "security_group_id",
+		},
+		"id": {
+			typeName:      "aws_vpc",
+			attributeName: "id",
+		},
+		"unknown type": {
+			typeName:      "aws_subnet",
+			attributeName: "outpost_arn",
+		},
+		"not a reference": {
+			typeName:      "aws_subnet",
+			attributeName: "cidr_block",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := referencedType(testCase.typeName, testCase.attributeName, knownTypes)
+
+			if got, want := ok, testCase.expectedOK; got != want {
+				t.Errorf("ok = %t, want %t", got, want)
+			}
+			if got, want := got, testCase.expected; got != want {
+				t.Errorf("type = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestDependencyGraph(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		sweepers    map[string]*resource.Sweeper
+		references  map[string][]string
+		expected    []string
+		expectedErr bool
+	}{
+		"declared dependencies": {
+			sweepers: map[string]*resource.Sweeper{
+				"aws_subnet": {Name: "aws_subnet"},
+				"aws_vpc":    {Name: "aws_vpc", Dependencies: []string{"aws_subnet", "aws_missing"}},
+			},
+			expected: []string{"aws_subnet", "aws_vpc"},
+		},
+		"schema references": {
+			sweepers: map[string]*resource.Sweeper{
+				"aws_security_group": {Name: "aws_security_group"},
+				"aws_subnet":         {Name: "aws_subnet"},
+				"aws_vpc":            {Name: "aws_vpc"},
+			},
+			references: map[string][]string{
+				"aws_security_group": {"aws_vpc"},
+				"aws_subnet":         {"aws_vpc"},
+			},
+			expected: []string{"aws_security_group", "aws_subnet", "aws_vpc"},
+		},
+		"schema reference cycle ignored": {
+			sweepers: map[string]*resource.Sweeper{
+				"aws_a": {Name: "aws_a", Dependencies: []string{"aws_b"}},
+				"aws_b": {Name: "aws_b"},
+			},
+			references: map[string][]string{
+				"aws_a": {"aws_b"},
+			},
+			expected: []string{"aws_b", "aws_a"},
+		},
+		"declared cycle": {
+			sweepers: map[string]*resource.Sweeper{
+				"aws_a": {Name: "aws_a", Dependencies: []string{"aws_b"}},
+				"aws_b": {Name: "aws_b", Dependencies: []string{"aws_a"}},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			g, err := dependencyGraph(ctx, testCase.sweepers, testCase.references)
+
+			if got, want := err != nil, testCase.expectedErr; got != want {
+				t.Fatalf("dependencyGraph() err %t, want %t: %v", got, want, err)
+			}
+			if err != nil {
+				return
+			}
+
+			got, err := g.OverallOrder()
+			if err != nil {
+				t.Fatalf("OverallOrder() err: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package describe contains the parts of resource description shared by the sweep package
+// and the Plugin SDK and Framework sweepable implementations.
+package describe
+
+import (
+	"context"
+)
+
+// ResourceDescription describes a resource to be swept.
+// A nil *ResourceDescription means that the resource no longer exists.
+type ResourceDescription struct {
+	Type string
+	ID   string
+	Name string // The resource's name, or ID if it has no name
+	Tags map[string]string
+}
+
+type contextKey int
+
+const (
+	regionKey contextKey = iota
+	resourceTypeKey
+)
+
+// WithRegion returns a new Context that records the Region being swept.
+func WithRegion(ctx context.Context, region string) context.Context {
+	return context.WithValue(ctx, regionKey, region)
+}
+
+// RegionFromContext returns the Region being swept.
+func RegionFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(regionKey).(string)
+	return v
+}
+
+// WithResourceType returns a new Context that records the resource type being swept.
+func WithResourceType(ctx context.Context, resourceType string) context.Context {
+	return context.WithValue(ctx, resourceTypeKey, resourceType)
+}
+
+// ResourceTypeFromContext returns the resource type being swept, if recorded.
+func ResourceTypeFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(resourceTypeKey).(string)
+	return v
+}
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package describe
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"sync"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// registration is a resource type registered by a service package.
+type registration struct {
+	servicePackage conns.ServicePackage
+	tags           *types.ServicePackageResourceTags
+}
+
+type registrationIndex struct {
+	// byTypeName maps resource type name to registration.
+	byTypeName map[string]registration
+	// sdkTypeNames maps the entry point of a Plugin SDK resource's delete function to its resource type names.
+	sdkTypeNames map[uintptr][]string
+}
+
+// ServicePackages returns the service packages whose resources may be swept.
+// It is set by the sweep package in order to break an import cycle.
+var ServicePackages func() []conns.ServicePackage
+
+var registrations = sync.OnceValue(func() *registrationIndex {
+	ctx := context.Background()
+	index := &registrationIndex{
+		byTypeName:   make(map[string]registration),
+		sdkTypeNames: make(map[uintptr][]string),
+	}
+
+	var servicePackages []conns.ServicePackage
+	if ServicePackages != nil {
+		servicePackages = ServicePackages()
+	}
+
+	for _, sp := range servicePackages {
+		for _, v := range sp.SDKResources(ctx) {
+			index.byTypeName[v.TypeName] = registration{
+				servicePackage: sp,
+				tags:           v.Tags,
+			}
+
+			if pc := sdkDeleteEntryPoint(v.Factory()); pc != 0 {
+				index.sdkTypeNames[pc] = append(index.sdkTypeNames[pc], v.TypeName)
+			}
+		}
+
+		for _, v := range sp.FrameworkResources(ctx) {
+			r, err := v.Factory(ctx)
+			if err != nil {
+				continue
+			}
+
+			var response fwresource.MetadataResponse
+			r.Metadata(ctx, fwresource.MetadataRequest{ProviderTypeName: "aws"}, &response)
+
+			index.byTypeName[response.TypeName] = registration{
+				servicePackage: sp,
+				tags:           v.Tags,
+			}
+		}
+	}
+
+	for _, v := range index.sdkTypeNames {
+		slices.Sort(v)
+	}
+
+	return index
+})
+
+// SDKResourceTypeName returns the resource type name of the specified Plugin SDK resource,
+// found by matching its delete function against the registered resources.
+// If several resource types share a delete function (e.g. aliases), the first by name is returned.
+func SDKResourceTypeName(r *schema.Resource) string {
+	if pc := sdkDeleteEntryPoint(r); pc != 0 {
+		if v := registrations().sdkTypeNames[pc]; len(v) > 0 {
+			return v[0]
+		}
+	}
+
+	return ""
+}
+
+func sdkDeleteEntryPoint(r *schema.Resource) uintptr {
+	for _, v := range []any{r.DeleteWithoutTimeout, r.DeleteContext, r.Delete} {
+		if v := reflect.ValueOf(v); !v.IsNil() {
+			return v.Pointer()
+		}
+	}
+
+	return 0
+}
+
+// ResourceTags returns the tags of a resource using its service package's generic ListTags method.
+// attribute returns the value of the resource's specified attribute.
+// A nil map is returned if the resource type does not support listing tags.
+func ResourceTags(ctx context.Context, client *conns.AWSClient, typeName string, attribute func(string) string) (map[string]string, error) {
+	v, ok := registrations().byTypeName[typeName]
+	if !ok || v.tags == nil || v.tags.IdentifierAttribute == "" {
+		return nil, nil
+	}
+
+	identifier := attribute(v.tags.IdentifierAttribute)
+	if identifier == "" {
+		return nil, nil
+	}
+
+	ctx = tftags.NewContext(ctx, nil, nil)
+
+	var err error
+	switch sp := v.servicePackage.(type) {
+	case tftags.ServiceTagLister:
+		err = sp.ListTags(ctx, client, identifier)
+	case tftags.ResourceTypeTagLister:
+		if v.tags.ResourceType == "" {
+			return nil, nil
+		}
+		err = sp.ListTags(ctx, client, identifier, v.tags.ResourceType)
+	default:
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		return inContext.TagsOut.UnwrapOrDefault().Map(), nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// readOnlyOperationPrefixes are the prefixes of AWS API operation names which do not modify resources.
+var readOnlyOperationPrefixes = []string{
+	"AssumeRole", // Credentials.
+	"BatchDescribe",
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+	"Select",
+}
+
+func isReadOnlyOperation(name string) bool {
+	for _, v := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(name, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type mutationsAllowedKey struct{}
+
+// withMutationsAllowed returns a new context in which AWS API calls which may modify resources are allowed.
+func withMutationsAllowed(ctx context.Context) context.Context {
+	return context.WithValue(ctx, mutationsAllowedKey{}, true)
+}
+
+func mutationsAllowed(ctx context.Context) bool {
+	v, _ := ctx.Value(mutationsAllowedKey{}).(bool)
+	return v
+}
+
+// mutationGuard refuses AWS API calls made by a Region's sweeper client which may modify resources,
+// other than those made by SweepOrchestrator, and records that a call has been refused.
+type mutationGuard struct {
+	refused atomic.Bool
+}
+
+// check is the client's conns.MutationGuardFunc.
+func (g *mutationGuard) check(ctx context.Context, serviceID, operation string) error {
+	if isReadOnlyOperation(operation) || mutationsAllowed(ctx) {
+		return nil
+	}
+
+	g.refused.Store(true)
+
+	return fmt.Errorf("%s %s: AWS API call may modify resources and mutations are not allowed", serviceID, operation)
+}
+
+// reset clears any recorded refusal and returns whether a call had been refused.
+func (g *mutationGuard) reset() bool {
+	return g.refused.Swap(false)
+}
+
+var (
+	// mutationGuards are the mutation guards of the sweeper clients, by Region.
+	mutationGuards     = make(map[string]*mutationGuard)
+	mutationGuardsLock sync.Mutex
+)
+
+// regionalMutationGuard returns the mutation guard of the specified Region's sweeper client.
+// In dry-run and filtered modes only SweepOrchestrator may delete resources; otherwise mutations are not guarded and nil is returned.
+func regionalMutationGuard(region string) (*mutationGuard, error) {
+	o, err := sweepOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	if !o.dryRun && !o.filtered() {
+		return nil, nil
+	}
+
+	mutationGuardsLock.Lock()
+	defer mutationGuardsLock.Unlock()
+
+	g, ok := mutationGuards[region]
+	if !ok {
+		g = new(mutationGuard)
+		mutationGuards[region] = g
+	}
+
+	return g, nil
+}
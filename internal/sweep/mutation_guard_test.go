@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMutationGuardCheck(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		operation string
+		allowed   bool
+		wantErr   bool
+	}{
+		{
+			name:      "read",
+			operation: "DescribeRouteTables",
+		},
+		{
+			name:      "list",
+			operation: "ListRoles",
+		},
+		{
+			name:      "delete",
+			operation: "DeleteRouteTable",
+			wantErr:   true,
+		},
+		{
+			name:      "other mutation",
+			operation: "DisableSecurityHub",
+			wantErr:   true,
+		},
+		{
+			name:      "delete allowed",
+			operation: "DeleteRouteTable",
+			allowed:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			if testCase.allowed {
+				ctx = withMutationsAllowed(ctx)
+			}
+
+			var g mutationGuard
+			err := g.check(ctx, "EC2", testCase.operation)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("check() err %t, want %t: %v", got, want, err)
+			}
+			if got, want := g.reset(), testCase.wantErr; got != want {
+				t.Errorf("refused %t, want %t", got, want)
+			}
+			if g.reset() {
+				t.Error("refused after reset")
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// sweepers holds all registered sweepers, keyed by name.
+var sweepers = make(map[string]*resource.Sweeper)
+
+// AddTestSweepers registers a sweeper to be run by TestMain when the -sweep flag is used.
+// Sweeper names must be unique and should be the Terraform resource type swept.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	if _, ok := sweepers[name]; ok {
+		log.Fatalf("[ERR] adding sweeper (%s): already registered", name)
+	}
+
+	sweepers[name] = s
+}
@@ -0,0 +1,255 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/describe"
+)
+
+// Describer is implemented by Sweepables which can describe the resource they delete.
+// Describing a resource may require reading it.
+type Describer interface {
+	Describe(ctx context.Context) (*ResourceDescription, error)
+}
+
+// ResourceDescription describes a resource to be swept.
+// A nil *ResourceDescription from Describe means that the resource no longer exists.
+type ResourceDescription = describe.ResourceDescription
+
+const (
+	reportActionDelete = "delete"
+	reportActionSkip   = "skip"
+)
+
+// ReportEntry is a single resource in a sweeper report.
+type ReportEntry struct {
+	Region string            `json:"region"`
+	Type   string            `json:"type"`
+	ID     string            `json:"id"`
+	Name   string            `json:"name"`
+	Tags   map[string]string `json:"tags,omitempty"`
+	Action string            `json:"action"`
+	Reason string            `json:"reason,omitempty"`
+	DryRun bool              `json:"dry_run"`
+}
+
+// options are the sweeper options configured via environment variables.
+type options struct {
+	dryRun            bool
+	reportPath        string
+	allowTags         []tagFilter
+	denyTags          []tagFilter
+	allowNamePrefixes []string
+	denyNamePrefixes  []string
+}
+
+// describe returns whether resources must be described before being swept.
+func (o *options) describe() bool {
+	return o.dryRun || o.reportPath != "" || o.filtered()
+}
+
+func (o *options) filtered() bool {
+	return len(o.allowTags) > 0 || len(o.denyTags) > 0 || len(o.allowNamePrefixes) > 0 || len(o.denyNamePrefixes) > 0
+}
+
+// skipReason returns why the described resource must not be swept, or "" if it may be swept.
+func (o *options) skipReason(description *ResourceDescription) string {
+	for _, v := range o.denyNamePrefixes {
+		if strings.HasPrefix(description.Name, v) {
+			return fmt.Sprintf("name matches denied prefix %q", v)
+		}
+	}
+
+	for _, v := range o.denyTags {
+		if v.match(description.Tags) {
+			return fmt.Sprintf("has denied tag %q", v)
+		}
+	}
+
+	if len(o.allowNamePrefixes) > 0 && !slices.ContainsFunc(o.allowNamePrefixes, func(v string) bool {
+		return strings.HasPrefix(description.Name, v)
+	}) {
+		return "name does not match any allowed prefix"
+	}
+
+	if len(o.allowTags) > 0 && !slices.ContainsFunc(o.allowTags, func(v tagFilter) bool {
+		return v.match(description.Tags)
+	}) {
+		return "has no allowed tag"
+	}
+
+	return ""
+}
+
+// tagFilter matches a tag key and, optionally, value.
+type tagFilter struct {
+	key   string
+	value *string
+}
+
+func (f tagFilter) match(tags map[string]string) bool {
+	v, ok := tags[f.key]
+	if !ok {
+		return false
+	}
+
+	return f.value == nil || *f.value == v
+}
+
+func (f tagFilter) String() string {
+	if f.value == nil {
+		return f.key
+	}
+
+	return f.key + "=" + *f.value
+}
+
+func parseTagFilters(s string) []tagFilter {
+	var filters []tagFilter
+
+	for _, v := range splitList(s) {
+		if key, value, ok := strings.Cut(v, "="); ok {
+			filters = append(filters, tagFilter{key: key, value: &value})
+		} else {
+			filters = append(filters, tagFilter{key: v})
+		}
+	}
+
+	return filters
+}
+
+func splitList(s string) []string {
+	var values []string
+
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+
+	return values
+}
+
+func optionsFromEnv() (*options, error) {
+	o := &options{
+		reportPath:        os.Getenv(envvar.SweepReport),
+		allowTags:         parseTagFilters(os.Getenv(envvar.SweepAllowTags)),
+		denyTags:          parseTagFilters(os.Getenv(envvar.SweepDenyTags)),
+		allowNamePrefixes: splitList(os.Getenv(envvar.SweepAllowNamePrefixes)),
+		denyNamePrefixes:  splitList(os.Getenv(envvar.SweepDenyNamePrefixes)),
+	}
+
+	if v := os.Getenv(envvar.SweepDryRun); v != "" {
+		dryRun, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", envvar.SweepDryRun, err)
+		}
+		o.dryRun = dryRun
+	}
+
+	return o, nil
+}
+
+var sweepOptions = sync.OnceValues(optionsFromEnv)
+
+// report collects the entries of the sweeper report.
+type report struct {
+	mu      sync.Mutex
+	entries []ReportEntry
+}
+
+func (r *report) add(entry ReportEntry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.entries = append(r.entries, entry)
+}
+
+func (r *report) sortedEntries() []ReportEntry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entries := slices.Clone(r.entries)
+	slices.SortStableFunc(entries, func(a, b ReportEntry) int {
+		if v := strings.Compare(a.Region, b.Region); v != 0 {
+			return v
+		}
+		if v := strings.Compare(a.Type, b.Type); v != 0 {
+			return v
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return entries
+}
+
+// write writes the report to the specified path, as CSV if the path ends in ".csv" and otherwise as JSON.
+func (r *report) write(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := r.encode(f, path); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// encode encodes the report to out, as CSV if path ends in ".csv" and otherwise as JSON.
+func (r *report) encode(out io.Writer, path string) error {
+	entries := r.sortedEntries()
+
+	if strings.HasSuffix(strings.ToLower(path), ".csv") {
+		w := csv.NewWriter(out)
+
+		if err := w.Write([]string{"region", "type", "id", "name", "tags", "action", "reason", "dry_run"}); err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			keys := tfmaps.Keys(entry.Tags)
+			slices.Sort(keys)
+			tags := make([]string, 0, len(keys))
+			for _, k := range keys {
+				tags = append(tags, k+"="+entry.Tags[k])
+			}
+
+			if err := w.Write([]string{entry.Region, entry.Type, entry.ID, entry.Name, strings.Join(tags, ";"), entry.Action, entry.Reason, strconv.FormatBool(entry.DryRun)}); err != nil {
+				return err
+			}
+		}
+
+		w.Flush()
+
+		return w.Error()
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+
+	if entries == nil {
+		entries = []ReportEntry{}
+	}
+
+	return encoder.Encode(entries)
+}
+
+// sweepReport is the report for the current run.
+var sweepReport report
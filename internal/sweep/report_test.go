@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestOptionsSkipReason(t *testing.T) {
+	t.Parallel()
+
+	description := &ResourceDescription{
+		Type: "aws_vpc",
+		ID:   "vpc-12345678",
+		Name: "tf-acc-test-12345",
+		Tags: map[string]string{
+			"Owner": "sandbox",
+			"Keep":  "true",
+		},
+	}
+
+	testCases := map[string]struct {
+		options  options
+		expected string
+	}{
+		"no filters": {},
+		"allowed name prefix": {
+			options: options{
+				allowNamePrefixes: []string{"tf-acc-test", "tf-test"},
+			},
+		},
+		"no allowed name prefix": {
+			options: options{
+				allowNamePrefixes: []string{"tf-test"},
+			},
+			expected: "name does not match any allowed prefix",
+		},
+		"denied name prefix": {
+			options: options{
+				denyNamePrefixes: []string{"tf-acc"},
+			},
+			expected: `name matches denied prefix "tf-acc"`,
+		},
+		"allowed tag key": {
+			options: options{
+				allowTags: parseTagFilters("Owner"),
+			},
+		},
+		"allowed tag key and value": {
+			options: options{
+				allowTags: parseTagFilters("Owner=other, Owner=sandbox"),
+			},
+		},
+		"no allowed tag": {
+			options: options{
+				allowTags: parseTagFilters("Owner=other"),
+			},
+			expected: "has no allowed tag",
+		},
+		"denied tag": {
+			options: options{
+				allowTags: parseTagFilters("Owner"),
+				denyTags:  parseTagFilters("Keep=true"),
+			},
+			expected: `has denied tag "Keep=true"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.options.skipReason(description), testCase.expected; got != want {
+				t.Errorf("skipReason() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+type mockSweepable struct {
+	description *ResourceDescription
+	deleted     *atomic.Int32
+}
+
+func (m mockSweepable) Delete(context.Context, time.Duration, ...tfresource.OptionsFunc) error {
+	m.deleted.Add(1)
+	return nil
+}
+
+func (m mockSweepable) Describe(context.Context) (*ResourceDescription, error) {
+	return m.description, nil
+}
+
+type mockUndescribableSweepable struct {
+	deleted *atomic.Int32
+}
+
+func (m mockUndescribableSweepable) Delete(context.Context, time.Duration, ...tfresource.OptionsFunc) error {
+	m.deleted.Add(1)
+	return nil
+}
+
+func TestSweepOrchestrator(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		options         options
+		expectedDeleted int32
+		expectedEntries []ReportEntry
+	}{
+		"no options": {
+			expectedDeleted: 3,
+		},
+		"dry run": {
+			options: options{
+				dryRun: true,
+			},
+			expectedEntries: []ReportEntry{
+				{Region: "us-west-2", Type: "aws_vpc", ID: "", Action: "delete", DryRun: true},
+				{Region: "us-west-2", Type: "aws_vpc", ID: "vpc-1", Name: "one", Tags: map[string]string{"Keep": "true"}, Action: "delete", DryRun: true},
+				{Region: "us-west-2", Type: "aws_vpc", ID: "vpc-2", Name: "two", Action: "delete", DryRun: true},
+			},
+		},
+		"filtered": {
+			options: options{
+				denyTags: parseTagFilters("Keep"),
+			},
+			expectedDeleted: 1,
+			expectedEntries: []ReportEntry{
+				{Region: "us-west-2", Type: "aws_vpc", ID: "", Action: "skip", Reason: "resource cannot be described"},
+				{Region: "us-west-2", Type: "aws_vpc", ID: "vpc-1", Name: "one", Tags: map[string]string{"Keep": "true"}, Action: "skip", Reason: `has denied tag "Keep"`},
+				{Region: "us-west-2", Type: "aws_vpc", ID: "vpc-2", Name: "two", Action: "delete"},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := WithResourceType(Context("us-west-2"), "aws_vpc")
+			var deleted atomic.Int32
+			var r report
+
+			sweepables := []Sweepable{
+				mockSweepable{
+					description: &ResourceDescription{ID: "vpc-1", Name: "one", Tags: map[string]string{"Keep": "true"}},
+					deleted:     &deleted,
+				},
+				mockSweepable{
+					description: &ResourceDescription{ID: "vpc-2", Name: "two"},
+					deleted:     &deleted,
+				},
+				mockUndescribableSweepable{
+					deleted: &deleted,
+				},
+			}
+
+			if err := sweepOrchestrator(ctx, &testCase.options, &r, sweepables); err != nil {
+				t.Fatalf("sweepOrchestrator() err: %s", err)
+			}
+
+			if got, want := deleted.Load(), testCase.expectedDeleted; got != want {
+				t.Errorf("deleted = %d, want %d", got, want)
+			}
+
+			if diff := cmp.Diff(r.sortedEntries(), testCase.expectedEntries); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestReportWrite(t *testing.T) {
+	t.Parallel()
+
+	var r report
+	r.add(ReportEntry{Region: "us-west-2", Type: "aws_vpc", ID: "vpc-2", Name: "two", Action: "delete", DryRun: true})
+	r.add(ReportEntry{Region: "us-west-2", Type: "aws_vpc", ID: "vpc-1", Name: "one", Tags: map[string]string{"b": "2", "a": "1"}, Action: "skip", Reason: "has no allowed tag", DryRun: true})
+
+	dir := t.TempDir()
+
+	t.Run("json", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(dir, "report.json")
+		if err := r.write(path); err != nil {
+			t.Fatalf("write() err: %s", err)
+		}
+
+		b, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var got []ReportEntry
+		if err := json.Unmarshal(b, &got); err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(got, r.sortedEntries()); diff != "" {
+			t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+		}
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(dir, "report.csv")
+		if err := r.write(path); err != nil {
+			t.Fatalf("write() err: %s", err)
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+
+		got, err := csv.NewReader(f).ReadAll()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expected := [][]string{
+			{"region", "type", "id", "name", "tags", "action", "reason", "dry_run"},
+			{"us-west-2", "aws_vpc", "vpc-1", "one", "a=1;b=2", "skip", "has no allowed tag", "true"},
+			{"us-west-2", "aws_vpc", "vpc-2", "two", "", "delete", "", "true"},
+		}
+
+		if diff := cmp.Diff(got, expected); diff != "" {
+			t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+		}
+	})
+}
@@ -0,0 +1,312 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+)
+
+const defaultSweepParallelism = 10
+
+// TestMain runs the registered sweepers if the -sweep flag is set, otherwise it runs the tests.
+//
+// The -sweep, -sweep-run and -sweep-allow-failures flags have the same meaning as for resource.TestMain.
+// Sweepers are ordered using a dependency graph built from their declared Dependencies and from
+// references between resource type schemas. Sweepers whose dependencies have completed are run
+// concurrently, up to the number set by the TF_AWS_SWEEP_PARALLELISM environment variable.
+//
+// See SweepOrchestrator for dry-run mode, reports and filtering.
+func TestMain(m interface {
+	Run() int
+}) {
+	flag.Parse()
+
+	regions := flagValue("sweep")
+	if regions == "" {
+		os.Exit(m.Run())
+	}
+
+	parallelism := defaultSweepParallelism
+	if v := os.Getenv(envvar.SweepParallelism); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			log.Fatalf("[ERR] environment variable %s: must be a positive integer", envvar.SweepParallelism)
+		}
+		parallelism = n
+	}
+
+	allowFailures, _ := strconv.ParseBool(flagValue("sweep-allow-failures"))
+
+	o, err := sweepOptions()
+	if err != nil {
+		log.Fatalf("[ERR] %s", err)
+	}
+	if o.dryRun {
+		log.Printf("[INFO] Dry run: no resources will be deleted, sweepers which delete resources directly are skipped")
+	}
+
+	ctx := context.Background()
+	references := schemaReferences(ctx, ServicePackages, tfmaps.Keys(sweepers))
+
+	g, err := dependencyGraph(ctx, sweepers, references)
+	if err != nil {
+		log.Fatalf("[ERR] ordering sweepers: %s", err)
+	}
+
+	selected, err := filterSweepers(g, flagValue("sweep-run"))
+	if err != nil {
+		log.Fatalf("[ERR] filtering sweepers: %s", err)
+	}
+
+	var failed bool
+	for _, region := range strings.Split(regions, ",") {
+		region = strings.TrimSpace(region)
+
+		// Options have already been validated.
+		guard, _ := regionalMutationGuard(region)
+
+		if err := runSweepers(Context(region), region, sweepers, g, selected, parallelism, allowFailures, guard); err != nil {
+			log.Printf("[ERR] sweeping region (%s): %s", region, err)
+			failed = true
+
+			if !allowFailures {
+				break
+			}
+		}
+	}
+
+	if o.reportPath != "" {
+		if err := sweepReport.write(o.reportPath); err != nil {
+			log.Printf("[ERR] writing sweeper report (%s): %s", o.reportPath, err)
+			failed = true
+		} else {
+			log.Printf("[INFO] Wrote sweeper report (%s)", o.reportPath)
+		}
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+}
+
+func flagValue(name string) string {
+	if f := flag.Lookup(name); f != nil {
+		return f.Value.String()
+	}
+
+	return ""
+}
+
+// filterSweepers returns the names of the sweepers matching the comma-separated filter, and
+// all of their dependencies. Matching is case-insensitive on any part of the sweeper name.
+// An empty filter matches all sweepers.
+func filterSweepers(g *depgraph.Graph, filter string) ([]string, error) {
+	all, err := g.OverallOrder()
+	if err != nil {
+		return nil, err
+	}
+
+	if filter == "" {
+		return all, nil
+	}
+
+	terms := strings.Split(strings.ToLower(filter), ",")
+	var selected []string
+
+	for _, name := range all {
+		if !slices.ContainsFunc(terms, func(term string) bool {
+			return strings.Contains(strings.ToLower(name), term)
+		}) {
+			continue
+		}
+
+		dependencies, err := g.DependenciesOf(name)
+		if err != nil {
+			return nil, err
+		}
+
+		selected = append(selected, name)
+		selected = append(selected, dependencies...)
+	}
+
+	// Preserve overall order.
+	return slices.DeleteFunc(all, func(name string) bool {
+		return !slices.Contains(selected, name)
+	}), nil
+}
+
+type sweeperResult struct {
+	name string
+	err  error
+}
+
+// runSweepers runs the selected sweepers in a region.
+// A sweeper is started once all of its dependencies have completed, with at most parallelism sweepers running at once.
+// If allowFailures is false no new sweepers are started after the first failure.
+//
+// If guard is not nil, a sweeper whose AWS API calls are refused by guard is skipped rather than failed.
+// Sweepers then run one at a time, as they share the Region's client, so that a refusal is attributed to the sweeper that made the call.
+func runSweepers(ctx context.Context, region string, registered map[string]*resource.Sweeper, g *depgraph.Graph, selected []string, parallelism int, allowFailures bool, guard *mutationGuard) error {
+	if guard != nil {
+		parallelism = 1
+	}
+
+	start := time.Now()
+	tflog.Info(ctx, "Running sweepers", map[string]any{
+		"count":       len(selected),
+		"parallelism": parallelism,
+	})
+
+	// Number of incomplete dependencies of each sweeper.
+	pending := make(map[string]int, len(selected))
+	var ready []string
+
+	for _, name := range selected {
+		dependencies, err := g.DirectDependenciesOf(name)
+		if err != nil {
+			return err
+		}
+
+		pending[name] = len(dependencies)
+		if len(dependencies) == 0 {
+			ready = append(ready, name)
+		}
+	}
+
+	jobs := make(chan string)
+	results := make(chan sweeperResult)
+
+	for range parallelism {
+		go func() {
+			for name := range jobs {
+				results <- sweeperResult{
+					name: name,
+					err:  runSweeper(ctx, region, registered[name], guard),
+				}
+			}
+		}()
+	}
+
+	var errs []error
+	var running int
+	stopped := false
+
+	for (len(ready) > 0 && !stopped) || running > 0 {
+		var send chan string
+		var next string
+
+		if len(ready) > 0 && !stopped {
+			send = jobs
+			next = ready[0]
+		}
+
+		select {
+		case send <- next:
+			ready = ready[1:]
+			running++
+
+		case result := <-results:
+			running--
+
+			if result.err != nil {
+				errs = append(errs, fmt.Errorf("sweeper (%s): %w", result.name, result.err))
+
+				if !allowFailures {
+					stopped = true
+				}
+			}
+
+			dependents, err := g.DirectDependentsOf(result.name)
+			if err != nil {
+				errs = append(errs, err)
+				stopped = true
+			}
+
+			for _, dependent := range dependents {
+				if _, ok := pending[dependent]; !ok {
+					continue
+				}
+
+				pending[dependent]--
+				if pending[dependent] == 0 {
+					ready = append(ready, dependent)
+				}
+			}
+		}
+	}
+
+	close(jobs)
+
+	tflog.Info(ctx, "Completed sweepers", map[string]any{
+		"duration": time.Since(start).String(),
+		"failures": len(errs),
+	})
+
+	return errors.Join(errs...)
+}
+
+func runSweeper(ctx context.Context, region string, s *resource.Sweeper, guard *mutationGuard) error {
+	start := time.Now()
+	tflog.Debug(ctx, "Running sweeper", map[string]any{
+		"sweeper": s.Name,
+	})
+
+	if guard != nil {
+		guard.reset()
+	}
+
+	err := s.F(region)
+
+	if guard != nil && guard.reset() {
+		// The sweeper deletes resources directly rather than via SweepOrchestrator.
+		// Options have already been validated by TestMain.
+		o, _ := sweepOptions()
+		fields := map[string]any{
+			"sweeper": s.Name,
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+		}
+		tflog.Warn(ctx, "Skipping sweeper: dry-run and filtered modes are not supported", fields)
+		sweepReport.add(ReportEntry{
+			Region: region,
+			Type:   s.Name,
+			Action: reportActionSkip,
+			Reason: "sweeper does not support dry-run and filtered modes",
+			DryRun: o.dryRun,
+		})
+
+		return nil
+	}
+
+	if err != nil {
+		tflog.Error(ctx, "Sweeper failed", map[string]any{
+			"sweeper":  s.Name,
+			"duration": time.Since(start).String(),
+			"error":    err.Error(),
+		})
+	} else {
+		tflog.Debug(ctx, "Completed sweeper", map[string]any{
+			"sweeper":  s.Name,
+			"duration": time.Since(start).String(),
+		})
+	}
+
+	return err
+}
@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestFilterSweepers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	g, err := dependencyGraph(ctx, map[string]*resource.Sweeper{
+		"aws_internet_gateway": {Name: "aws_internet_gateway"},
+		"aws_subnet":           {Name: "aws_subnet"},
+		"aws_vpc":              {Name: "aws_vpc", Dependencies: []string{"aws_internet_gateway", "aws_subnet"}},
+		"aws_iam_role":         {Name: "aws_iam_role"},
+	}, nil)
+	if err != nil {
+		t.Fatalf("dependencyGraph() err: %s", err)
+	}
+
+	testCases := map[string]struct {
+		filter   string
+		expected []string
+	}{
+		"empty": {
+			expected: []string{"aws_iam_role", "aws_internet_gateway", "aws_subnet", "aws_vpc"},
+		},
+		"with dependencies": {
+			filter:   "AWS_VPC",
+			expected: []string{"aws_internet_gateway", "aws_subnet", "aws_vpc"},
+		},
+		"multiple": {
+			filter:   "subnet,iam",
+			expected: []string{"aws_iam_role", "aws_subnet"},
+		},
+		"no match": {
+			filter:   "aws_s3_bucket",
+			expected: []string{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := filterSweepers(g, testCase.filter)
+			if err != nil {
+				t.Fatalf("filterSweepers() err: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRunSweepers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var mu sync.Mutex
+	var completed []string
+
+	sweeper := func(name string, err error, dependencies ...string) *resource.Sweeper {
+		return &resource.Sweeper{
+			Name:         name,
+			Dependencies: dependencies,
+			F: func(string) error {
+				mu.Lock()
+				defer mu.Unlock()
+
+				for _, dependency := range dependencies {
+					if !slices.Contains(completed, dependency) {
+						t.Errorf("sweeper (%s) run before dependency (%s)", name, dependency)
+					}
+				}
+
+				completed = append(completed, name)
+
+				return err
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		sweepers      map[string]*resource.Sweeper
+		allowFailures bool
+		expected      []string
+		expectedErr   bool
+	}{
+		"success": {
+			sweepers: map[string]*resource.Sweeper{
+				"aws_a": sweeper("aws_a", nil),
+				"aws_b": sweeper("aws_b", nil, "aws_a"),
+				"aws_c": sweeper("aws_c", nil, "aws_a"),
+				"aws_d": sweeper("aws_d", nil, "aws_b", "aws_c"),
+				"aws_e": sweeper("aws_e", nil),
+			},
+			expected: []string{"aws_a", "aws_b", "aws_c", "aws_d", "aws_e"},
+		},
+		"failure": {
+			sweepers: map[string]*resource.Sweeper{
+				"aws_a": sweeper("aws_a", errors.New("failed")),
+				"aws_b": sweeper("aws_b", nil, "aws_a"),
+			},
+			expected:    []string{"aws_a"},
+			expectedErr: true,
+		},
+		"allow failures": {
+			sweepers: map[string]*resource.Sweeper{
+				"aws_a": sweeper("aws_a", errors.New("failed")),
+				"aws_b": sweeper("aws_b", nil, "aws_a"),
+			},
+			allowFailures: true,
+			expected:      []string{"aws_a", "aws_b"},
+			expectedErr:   true,
+		},
+	}
+
+	for name, testCase := range testCases { //nolint:paralleltest // Test cases share completed.
+		t.Run(name, func(t *testing.T) {
+			completed = nil
+
+			g, err := dependencyGraph(ctx, testCase.sweepers, nil)
+			if err != nil {
+				t.Fatalf("dependencyGraph() err: %s", err)
+			}
+
+			selected, err := filterSweepers(g, "")
+			if err != nil {
+				t.Fatalf("filterSweepers() err: %s", err)
+			}
+
+			err = runSweepers(ctx, "us-west-2", testCase.sweepers, g, selected, 2, testCase.allowFailures, nil)
+
+			if got, want := err != nil, testCase.expectedErr; got != want {
+				t.Errorf("runSweepers() err %t, want %t: %v", got, want, err)
+			}
+
+			slices.Sort(completed)
+			if diff := cmp.Diff(completed, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRunSweepersMutationRefused(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	guard := new(mutationGuard)
+
+	sweepers := map[string]*resource.Sweeper{
+		// Deletes a resource directly, losing the refusal error's identity.
+		"aws_test_refused_direct": {
+			Name: "aws_test_refused_direct",
+			F: func(string) error {
+				if err := guard.check(ctx, "EC2", "DeleteVpc"); err != nil {
+					return fmt.Errorf("deleting EC2 VPC: %s", err)
+				}
+
+				return nil
+			},
+		},
+		"aws_test_refused_orchestrated": {
+			Name: "aws_test_refused_orchestrated",
+			F: func(string) error {
+				return guard.check(withMutationsAllowed(ctx), "EC2", "DeleteVpc")
+			},
+		},
+		"aws_test_refused_failed": {
+			Name: "aws_test_refused_failed",
+			F: func(string) error {
+				if err := guard.check(ctx, "EC2", "DescribeVpcs"); err != nil {
+					return err
+				}
+
+				return errors.New("failed")
+			},
+		},
+	}
+
+	g, err := dependencyGraph(ctx, sweepers, nil)
+	if err != nil {
+		t.Fatalf("dependencyGraph() err: %s", err)
+	}
+
+	selected, err := filterSweepers(g, "")
+	if err != nil {
+		t.Fatalf("filterSweepers() err: %s", err)
+	}
+
+	err = runSweepers(ctx, "us-west-2", sweepers, g, selected, 2, true, guard)
+
+	if err == nil {
+		t.Fatal("runSweepers() err nil, want error")
+	}
+	if got, want := err.Error(), "sweeper (aws_test_refused_failed): failed"; got != want {
+		t.Errorf("runSweepers() err %q, want %q", got, want)
+	}
+
+	var skipped []string
+	for _, entry := range sweepReport.sortedEntries() {
+		if entry.Action == reportActionSkip && entry.Region == "us-west-2" {
+			skipped = append(skipped, entry.Type)
+		}
+	}
+
+	if diff := cmp.Diff(skipped, []string{"aws_test_refused_direct"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
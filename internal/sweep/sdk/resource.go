@@ -5,6 +5,7 @@ package sdk
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"strings"
 	"time"
@@ -16,8 +17,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/describe"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type sweepResource struct {
@@ -89,6 +94,11 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// Describe reads the resource and returns its description.
+func (sr *sweepResource) Describe(ctx context.Context) (*describe.ResourceDescription, error) {
+	return describeResource(ctx, sr.resource, sr.d, sr.meta)
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
@@ -105,6 +115,63 @@ func (rsr *readerSweepResource) Read(ctx context.Context) error {
 	return ReadResource(ctx, rsr.resource, rsr.d, rsr.meta)
 }
 
+func describeResource(ctx context.Context, resource *schema.Resource, d *schema.ResourceData, meta *conns.AWSClient) (*describe.ResourceDescription, error) {
+	typeName := describe.ResourceTypeFromContext(ctx)
+	if typeName == "" {
+		typeName = describe.SDKResourceTypeName(resource)
+	}
+
+	ctx = tflog.SetField(ctx, "id", d.Id())
+	ctx = tftags.NewContext(ctx, nil, nil)
+
+	if err := ReadResource(ctx, resource, d, meta); err != nil {
+		return nil, err
+	}
+
+	if d.Id() == "" {
+		return nil, nil
+	}
+
+	description := &describe.ResourceDescription{
+		Type: typeName,
+		ID:   d.Id(),
+		Name: d.Id(),
+	}
+
+	schemaMap := resource.SchemaMap()
+
+	if _, ok := schemaMap[names.AttrName]; ok {
+		if v, ok := d.Get(names.AttrName).(string); ok && v != "" {
+			description.Name = v
+		}
+	}
+
+	if _, ok := schemaMap[names.AttrTags]; ok {
+		// Tags may have been set by the R handler, otherwise try the service API.
+		if inContext, ok := tftags.FromContext(ctx); ok && inContext.TagsOut.IsSome() {
+			description.Tags = inContext.TagsOut.UnwrapOrDefault().Map()
+		} else if v, ok := d.Get(names.AttrTags).(map[string]any); ok && len(v) > 0 {
+			description.Tags = flex.ExpandStringValueMap(v)
+		} else {
+			tags, err := describe.ResourceTags(ctx, meta, typeName, func(name string) string {
+				if name == names.AttrID {
+					return d.Id()
+				}
+				v, _ := d.Get(name).(string)
+				return v
+			})
+
+			if err != nil {
+				return nil, fmt.Errorf("listing tags: %w", err)
+			}
+
+			description.Tags = tags
+		}
+	}
+
+	return description, nil
+}
+
 func deleteResource(ctx context.Context, resource *schema.Resource, d *schema.ResourceData, meta *conns.AWSClient) error {
 	if resource.DeleteContext != nil || resource.DeleteWithoutTimeout != nil {
 		var diags diag.Diagnostics
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
@@ -15,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/describe"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -29,12 +31,24 @@ const defaultSweeperAssumeRoleDurationSeconds = 3600
 // ServicePackages is set in TestMain in order to break an import cycle.
 var ServicePackages []conns.ServicePackage
 
+func init() {
+	describe.ServicePackages = func() []conns.ServicePackage {
+		return ServicePackages
+	}
+}
+
 // sweeperClients is a shared cache of regional conns.AWSClient
 // This prevents client re-initialization for every resource with no benefit.
 var sweeperClients map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
 
+// sweeperClientsLock serializes access to sweeperClients as sweepers run concurrently.
+var sweeperClientsLock sync.Mutex
+
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper functions for a given Region.
 func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSClient, error) {
+	sweeperClientsLock.Lock()
+	defer sweeperClientsLock.Unlock()
+
 	if client, ok := sweeperClients[region]; ok {
 		return client, nil
 	}
@@ -59,12 +73,21 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 	}
 	meta.ServicePackages = servicePackageMap
 
+	guard, err := regionalMutationGuard(region)
+	if err != nil {
+		return nil, err
+	}
+
 	conf := &conns.Config{
 		MaxRetries:       5,
 		Region:           region,
 		SuppressDebugLog: true,
 	}
 
+	if guard != nil {
+		conf.MutationGuard = guard.check
+	}
+
 	if role := os.Getenv(envvar.AssumeRoleARN); role != "" {
 		ar := awsbase.AssumeRole{
 			RoleARN:  role,
@@ -106,7 +129,24 @@ type Sweepable interface {
 	Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error
 }
 
+// SweepOrchestrator deletes the specified resources concurrently.
+//
+// If dry-run mode, a report or allow/deny filters are configured via environment variables,
+// each resource is first described. Resources which are filtered out are not deleted and
+// in dry-run mode no resources are deleted. Described resources are added to the report.
+// In dry-run and filtered modes the clients returned by SharedRegionalSweepClient refuse AWS API calls
+// which may modify resources, other than those made deleting resources here, so sweepers must
+// return their resources to SweepOrchestrator rather than deleting them directly.
 func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
+	o, err := sweepOptions()
+	if err != nil {
+		return err
+	}
+
+	return sweepOrchestrator(ctx, o, &sweepReport, sweepables, optFns...)
+}
+
+func sweepOrchestrator(ctx context.Context, o *options, r *report, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
 	if len(sweepables) == 0 {
 		tflog.Info(ctx, "No resources to sweep")
 	}
@@ -115,7 +155,61 @@ func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tf
 
 	for _, sweepable := range sweepables {
 		g.Go(func() error {
-			return sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
+			if !o.describe() {
+				return sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
+			}
+
+			entry := ReportEntry{
+				Region: describe.RegionFromContext(ctx),
+				Type:   describe.ResourceTypeFromContext(ctx),
+				Action: reportActionDelete,
+				DryRun: o.dryRun,
+			}
+
+			if describer, ok := sweepable.(Describer); ok {
+				description, err := describer.Describe(ctx)
+
+				if err != nil {
+					return fmt.Errorf("describing resource: %w", err)
+				}
+
+				if description == nil {
+					tflog.Info(ctx, "Resource no longer exists")
+					return nil
+				}
+
+				if description.Type != "" {
+					entry.Type = description.Type
+				}
+				entry.ID = description.ID
+				entry.Name = description.Name
+				entry.Tags = description.Tags
+
+				if reason := o.skipReason(description); reason != "" {
+					entry.Action, entry.Reason = reportActionSkip, reason
+				}
+			} else if o.filtered() {
+				// Don't delete what can't be checked against the filters.
+				entry.Action, entry.Reason = reportActionSkip, "resource cannot be described"
+			}
+
+			r.add(entry)
+
+			ctx := tflog.SetField(ctx, "id", entry.ID)
+
+			if entry.Action == reportActionSkip {
+				tflog.Info(ctx, "Skipping resource", map[string]any{
+					"reason": entry.Reason,
+				})
+				return nil
+			}
+
+			if o.dryRun {
+				tflog.Info(ctx, "Dry run: resource would be deleted")
+				return nil
+			}
+
+			return sweepable.Delete(withMutationsAllowed(ctx), ThrottlingRetryTimeout, optFns...)
 		})
 	}
 
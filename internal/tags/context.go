@@ -17,20 +17,47 @@ type InContext struct {
 	TagsIn option.Option[KeyValueTags]
 	// TagsOut holds tags returned from AWS, including any ignored or system tags.
 	TagsOut option.Option[KeyValueTags]
+	// TagsPropagated holds tags that AWS propagates to the resource from a parent resource.
+	// It is set by resources whose tags are known to be propagated, e.g. instances launched from a launch template with tag specifications
+	// or by an Auto Scaling group, and for resources created by a CloudFormation stack.
+	TagsPropagated option.Option[KeyValueTags]
 }
 
 // NewContext returns a Context enhanced with tagging information.
 func NewContext(ctx context.Context, defaultConfig *DefaultConfig, ignoreConfig *IgnoreConfig) context.Context {
 	v := InContext{
-		DefaultConfig: defaultConfig,
-		IgnoreConfig:  ignoreConfig,
-		TagsIn:        option.None[KeyValueTags](),
-		TagsOut:       option.None[KeyValueTags](),
+		DefaultConfig:  defaultConfig,
+		IgnoreConfig:   ignoreConfig,
+		TagsIn:         option.None[KeyValueTags](),
+		TagsOut:        option.None[KeyValueTags](),
+		TagsPropagated: option.None[KeyValueTags](),
 	}
 
 	return context.WithValue(ctx, tagKey, &v)
 }
 
+// IgnorePropagated returns whether the provider is configured to ignore tags propagated from a parent resource.
+// Resources need only look up their parent resources' tags if it returns true.
+func (v *InContext) IgnorePropagated() bool {
+	return v.IgnoreConfig != nil && v.IgnoreConfig.Propagated
+}
+
+// AddTagsPropagated adds tags to those propagated to the resource from a parent resource.
+func (v *InContext) AddTagsPropagated(tags KeyValueTags) {
+	v.TagsPropagated = option.Some(v.TagsPropagated.UnwrapOrDefault().Merge(tags))
+}
+
+// RemoveIgnoredPropagated returns tags without those propagated to the resource from a parent resource that are not configured,
+// if the provider is configured to ignore propagated tags.
+// configured are the resource's configured tags, excluding any default tags.
+func (v *InContext) RemoveIgnoredPropagated(tags, configured KeyValueTags) KeyValueTags {
+	if !v.IgnorePropagated() || v.TagsPropagated.IsNone() {
+		return tags
+	}
+
+	return tags.RemovePropagated(configured, v.DefaultConfig, v.TagsPropagated.MustUnwrap())
+}
+
 func FromContext(ctx context.Context) (*InContext, bool) {
 	v, ok := ctx.Value(tagKey).(*InContext)
 	return v, ok
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"path"
+	"slices"
+)
+
+// DefaultRule contains tags to default across the resources in its scope.
+// A resource is in scope if its service and resource type match the rule and are not excluded by it.
+type DefaultRule struct {
+	// ExcludeResourceTypes are resource type patterns, e.g. "aws_iam_*", excluded from the rule.
+	ExcludeResourceTypes []string
+	// ExcludeServices are service package names, e.g. "route53", excluded from the rule.
+	ExcludeServices []string
+	// ResourceTypes are resource type patterns, e.g. "aws_ec2_*", that the rule applies to. Any resource type matches if empty.
+	ResourceTypes []string
+	// Services are service package names, e.g. "ec2", that the rule applies to. Any service matches if empty.
+	Services []string
+	Tags     KeyValueTags
+}
+
+func (r DefaultRule) appliesTo(servicePackageName, typeName string) bool {
+	if len(r.Services) > 0 && !slices.Contains(r.Services, servicePackageName) {
+		return false
+	}
+
+	if len(r.ResourceTypes) > 0 && !matchesAny(r.ResourceTypes, typeName) {
+		return false
+	}
+
+	if slices.Contains(r.ExcludeServices, servicePackageName) || matchesAny(r.ExcludeResourceTypes, typeName) {
+		return false
+	}
+
+	return true
+}
+
+func matchesAny(patterns []string, typeName string) bool {
+	return slices.ContainsFunc(patterns, func(pattern string) bool {
+		ok, _ := path.Match(pattern, typeName)
+		return ok
+	})
+}
+
+// ForResource returns the default tags configuration for a resource of the specified type in the specified service package.
+// Its Tags are the configuration's Tags merged with the Tags of any rules in scope, in order.
+// nil is returned if no tags apply to the resource.
+func (dc *DefaultConfig) ForResource(servicePackageName, typeName string) *DefaultConfig {
+	if dc == nil || len(dc.Rules) == 0 {
+		return dc
+	}
+
+	tags := dc.Tags
+
+	for _, rule := range dc.Rules {
+		if !rule.appliesTo(servicePackageName, typeName) {
+			continue
+		}
+
+		if tags == nil {
+			tags = make(KeyValueTags)
+		}
+		tags = tags.Merge(rule.Tags)
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	return &DefaultConfig{
+		Tags: tags,
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDefaultConfigForResource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	config := &DefaultConfig{
+		Tags: New(ctx, map[string]string{
+			"Owner": "platform",
+		}),
+		Rules: []DefaultRule{
+			{
+				Services: []string{"ec2"},
+				Tags: New(ctx, map[string]string{
+					"Backup": "daily",
+				}),
+			},
+			{
+				ExcludeResourceTypes: []string{"aws_ec2_transit_gateway*"},
+				ResourceTypes:        []string{"aws_ec2_*", "aws_instance"},
+				Tags: New(ctx, map[string]string{
+					"Owner": "compute",
+				}),
+			},
+			{
+				ExcludeServices: []string{"iam"},
+				Tags: New(ctx, map[string]string{
+					"CostCenter": "1234",
+				}),
+			},
+		},
+	}
+
+	testCases := []struct {
+		name               string
+		config             *DefaultConfig
+		servicePackageName string
+		typeName           string
+		want               map[string]string
+	}{
+		{
+			name:               "nil config",
+			servicePackageName: "ec2",
+			typeName:           "aws_instance",
+		},
+		{
+			name: "no rules",
+			config: &DefaultConfig{
+				Tags: New(ctx, map[string]string{"Owner": "platform"}),
+			},
+			servicePackageName: "s3",
+			typeName:           "aws_s3_bucket",
+			want:               map[string]string{"Owner": "platform"},
+		},
+		{
+			name:               "all rules",
+			config:             config,
+			servicePackageName: "ec2",
+			typeName:           "aws_instance",
+			want: map[string]string{
+				"Backup":     "daily",
+				"CostCenter": "1234",
+				"Owner":      "compute",
+			},
+		},
+		{
+			name:               "excluded resource type",
+			config:             config,
+			servicePackageName: "ec2",
+			typeName:           "aws_ec2_transit_gateway_vpc_attachment",
+			want: map[string]string{
+				"Backup":     "daily",
+				"CostCenter": "1234",
+				"Owner":      "platform",
+			},
+		},
+		{
+			name:               "excluded service",
+			config:             config,
+			servicePackageName: "iam",
+			typeName:           "aws_iam_role",
+			want: map[string]string{
+				"Owner": "platform",
+			},
+		},
+		{
+			name: "rules only",
+			config: &DefaultConfig{
+				Rules: []DefaultRule{
+					{
+						Services: []string{"s3"},
+						Tags:     New(ctx, map[string]string{"DataClass": "internal"}),
+					},
+				},
+			},
+			servicePackageName: "sqs",
+			typeName:           "aws_sqs_queue",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.config.ForResource(testCase.servicePackageName, testCase.typeName)
+
+			if got == nil {
+				if testCase.want != nil {
+					t.Fatalf("expected default tags %v, got nil", testCase.want)
+				}
+				return
+			}
+
+			if diff := cmp.Diff(got.Tags.Map(), testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -5,13 +5,17 @@ package tags
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
 	"reflect"
+	"regexp"
 	"slices"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/go-cty/cty"
 	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -49,15 +53,50 @@ const (
 	IgnoreTagsKeyPrefixesEnvVar = "TF_AWS_IGNORE_TAGS_KEY_PREFIXES"
 )
 
+const (
+	maxTagsPerResource = 50
+	maxTagKeyLength    = 128
+	maxTagValueLength  = 256
+)
+
+var (
+	tagCharactersRegexp = regexache.MustCompile(`^[\p{L}\p{Z}\p{N}_.:/=+\-@]*$`)
+)
+
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+	// Rules contain tags to default across a subset of resources.
+	// Use ForResource to obtain the configuration for a specific resource.
+	Rules []DefaultRule
 }
 
 // IgnoreConfig contains various options for removing resource tags.
 type IgnoreConfig struct {
 	Keys        KeyValueTags
 	KeyPrefixes KeyValueTags
+	// KeyPatterns are regular expressions matching the keys of tags to remove.
+	KeyPatterns []*regexp.Regexp
+	// Tags match the keys and values of tags to remove.
+	Tags []IgnoreTag
+	// Propagated removes tags propagated to a resource from a parent resource unless they are configured.
+	// Unlike the other options, it is applied by RemoveIgnoredPropagated.
+	Propagated bool
+}
+
+// IgnoreTag matches tags by key and, optionally, value.
+type IgnoreTag struct {
+	KeyPattern *regexp.Regexp
+	// ValuePattern matches any value if nil.
+	ValuePattern *regexp.Regexp
+}
+
+func (it IgnoreTag) matches(k string, v *TagData) bool {
+	if it.KeyPattern == nil || !it.KeyPattern.MatchString(k) {
+		return false
+	}
+
+	return it.ValuePattern == nil || it.ValuePattern.MatchString(v.ValueString())
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -126,6 +165,8 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 
 	result := tags.IgnorePrefixes(config.KeyPrefixes)
 	result = result.Ignore(config.Keys)
+	result = result.IgnorePatterns(config.KeyPatterns)
+	result = result.IgnoreTags(config.Tags)
 
 	return result
 }
@@ -177,6 +218,48 @@ func (tags KeyValueTags) IgnorePrefixes(ignoreTagPrefixes KeyValueTags) KeyValue
 	return result
 }
 
+// IgnorePatterns returns tag keys not matching any of the regular expressions.
+func (tags KeyValueTags) IgnorePatterns(ignoreTagPatterns []*regexp.Regexp) KeyValueTags {
+	if len(ignoreTagPatterns) == 0 {
+		return tags
+	}
+
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if slices.ContainsFunc(ignoreTagPatterns, func(re *regexp.Regexp) bool {
+			return re.MatchString(k)
+		}) {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// IgnoreTags returns tags not matching any of the key and value matchers.
+func (tags KeyValueTags) IgnoreTags(ignoreTags []IgnoreTag) KeyValueTags {
+	if len(ignoreTags) == 0 {
+		return tags
+	}
+
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if slices.ContainsFunc(ignoreTags, func(it IgnoreTag) bool {
+			return it.matches(k, v)
+		}) {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 // IgnoreServerlessApplicationRepository returns non-AWS and non-ServerlessApplicationRepository tag keys.
 func (tags KeyValueTags) IgnoreServerlessApplicationRepository() KeyValueTags {
 	result := make(KeyValueTags)
@@ -426,6 +509,39 @@ func (tags KeyValueTags) HasZeroValue() bool {
 	return false
 }
 
+// Validate returns an error if the tags do not conform to the limits
+// common to most AWS services.
+// Ref: https://docs.aws.amazon.com/tag-editor/latest/userguide/tagging.html#tag-conventions
+func (tags KeyValueTags) Validate() error {
+	var errs []error
+
+	if n := len(tags); n > maxTagsPerResource {
+		errs = append(errs, fmt.Errorf("number of tags (%d) exceeds the maximum of %d", n, maxTagsPerResource))
+	}
+
+	for _, k := range tags.Keys() {
+		if n := utf8.RuneCountInString(k); n < 1 || n > maxTagKeyLength {
+			errs = append(errs, fmt.Errorf("tag key (%s) length must be between 1 and %d characters", k, maxTagKeyLength))
+		}
+		if strings.HasPrefix(strings.ToLower(k), awsTagKeyPrefix) {
+			errs = append(errs, fmt.Errorf("tag key (%s) must not begin with %q", k, awsTagKeyPrefix))
+		}
+		if !tagCharactersRegexp.MatchString(k) {
+			errs = append(errs, fmt.Errorf("tag key (%s) contains invalid characters", k))
+		}
+
+		v := tags[k].ValueString()
+		if n := utf8.RuneCountInString(v); n > maxTagValueLength {
+			errs = append(errs, fmt.Errorf("tag (%s) value length must be at most %d characters", k, maxTagValueLength))
+		}
+		if !tagCharactersRegexp.MatchString(v) {
+			errs = append(errs, fmt.Errorf("tag (%s) value contains invalid characters", k))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 // Equal returns whether or two sets of key-value tags are equal.
 func (tags KeyValueTags) Equal(other KeyValueTags) bool {
 	if tags == nil && other == nil {
@@ -496,6 +612,46 @@ func (tags KeyValueTags) RemoveDefaultConfig(dc *DefaultConfig) KeyValueTags {
 	return result
 }
 
+// sources returns the source of each tag, given the resource's configured tags, the provider's default tags
+// and the tags propagated to the resource from a parent resource.
+// A tag with the same value in more than one source is attributed to the first of configuration, default tags and propagation.
+func (tags KeyValueTags) sources(configured KeyValueTags, dc *DefaultConfig, propagated KeyValueTags) map[string]tagSource {
+	result := make(map[string]tagSource, len(tags))
+
+	for k, v := range tags {
+		switch {
+		case configured.KeyExists(k) && v.Equal(configured[k]):
+			result[k] = configuration
+		case dc.GetTags().KeyExists(k) && v.Equal(dc.GetTags()[k]):
+			result[k] = defaultConfiguration
+		case propagated.KeyExists(k) && v.Equal(propagated[k]):
+			result[k] = propagation
+		default:
+			result[k] = api
+		}
+	}
+
+	return result
+}
+
+// RemovePropagated returns tags without those whose only source is propagation from a parent resource.
+// Tags propagated from a parent resource that are also configured on the resource or in the provider's default tags are kept.
+func (tags KeyValueTags) RemovePropagated(configured KeyValueTags, dc *DefaultConfig, propagated KeyValueTags) KeyValueTags {
+	if len(propagated) == 0 {
+		return tags
+	}
+
+	result := make(KeyValueTags)
+
+	for k, source := range tags.sources(configured, dc, propagated) {
+		if source != propagation {
+			result[k] = tags[k]
+		}
+	}
+
+	return result
+}
+
 // String returns the default string representation of the KeyValueTags.
 func (tags KeyValueTags) String() string {
 	var builder strings.Builder
@@ -735,6 +891,12 @@ const (
 	configuration tagSource = iota
 	plan
 	state
+	// defaultConfiguration tags are configured in the provider's default_tags.
+	defaultConfiguration
+	// propagation tags are propagated by AWS to the resource from a parent resource, e.g. an Auto Scaling group or a launch template.
+	propagation
+	// api tags have no known source other than the AWS API.
+	api
 )
 
 // configTag contains the value and source of the incoming tag
@@ -5,8 +5,12 @@ package tags
 
 import (
 	"context"
+	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -564,6 +568,74 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 				"key3": "value3",
 			},
 		},
+		{
+			name: "key patterns",
+			tags: New(ctx, map[string]string{
+				"key1":                   "value1",
+				"vendor:scan:2024-01-01": "value2",
+				"vendor:scan:2024-02-01": "value3",
+				"vendor:owner":           "value4",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyPatterns: []*regexp.Regexp{
+					regexache.MustCompile(`^vendor:scan:\d{4}-\d{2}-\d{2}$`),
+				},
+			},
+			want: map[string]string{
+				"key1":         "value1",
+				"vendor:owner": "value4",
+			},
+		},
+		{
+			name: "key and value matchers",
+			tags: New(ctx, map[string]string{
+				"key1":         "value1",
+				"backup":       "aws-backup-plan",
+				"backup-other": "manual",
+				"cost":         "",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Tags: []IgnoreTag{
+					{
+						KeyPattern:   regexache.MustCompile(`^backup`),
+						ValuePattern: regexache.MustCompile(`^aws-backup-`),
+					},
+					{
+						KeyPattern: regexache.MustCompile(`^cost$`),
+					},
+				},
+			},
+			want: map[string]string{
+				"key1":         "value1",
+				"backup-other": "manual",
+			},
+		},
+		{
+			name: "all",
+			tags: New(ctx, map[string]string{
+				"key1":                   "value1",
+				"key2":                   "value2",
+				"prefix:key3":            "value3",
+				"vendor:scan:2024-01-01": "value4",
+				"key5":                   "ignored",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Keys:        New(ctx, []string{"key2"}),
+				KeyPrefixes: New(ctx, []string{"prefix:"}),
+				KeyPatterns: []*regexp.Regexp{
+					regexache.MustCompile(`^vendor:scan:`),
+				},
+				Tags: []IgnoreTag{
+					{
+						KeyPattern:   regexache.MustCompile(`.*`),
+						ValuePattern: regexache.MustCompile(`^ignored$`),
+					},
+				},
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -2262,6 +2334,98 @@ func TestKeyValueTagsRemoveDefaultConfig(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsRemovePropagated(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name          string
+		tags          KeyValueTags
+		configured    KeyValueTags
+		defaultConfig *DefaultConfig
+		propagated    KeyValueTags
+		want          map[string]string
+	}{
+		{
+			name: "no propagated tags",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "propagated tags",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			configured: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+			propagated: New(ctx, map[string]string{
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "propagated tags also configured",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			configured: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key3": "value3",
+				}),
+			},
+			propagated: New(ctx, map[string]string{
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
+		{
+			name: "propagated tags changed",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "value2-changed",
+			}),
+			propagated: New(ctx, map[string]string{
+				"key2": "value2",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2-changed",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.tags.RemovePropagated(testCase.configured, testCase.defaultConfig, testCase.propagated)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsURLEncode(t *testing.T) {
 	t.Parallel()
 
@@ -2384,6 +2548,88 @@ func TestKeyValueTagsURLQueryString(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsValidate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tooMany := make(map[string]string)
+	for i := range 51 {
+		tooMany[fmt.Sprintf("key%d", i)] = "value"
+	}
+
+	testCases := []struct {
+		name    string
+		tags    KeyValueTags
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			tags: New(ctx, map[string]string{}),
+		},
+		{
+			name: "valid",
+			tags: New(ctx, map[string]string{
+				"Name":         "value 1",
+				"app:tier/env": "+-=._:/@",
+				"Ключ":         "значение",
+				"empty":        "",
+			}),
+		},
+		{
+			name:    "too many",
+			tags:    New(ctx, tooMany),
+			wantErr: true,
+		},
+		{
+			name: "key too long",
+			tags: New(ctx, map[string]string{
+				strings.Repeat("k", 129): "value",
+			}),
+			wantErr: true,
+		},
+		{
+			name: "value too long",
+			tags: New(ctx, map[string]string{
+				"key": strings.Repeat("v", 257),
+			}),
+			wantErr: true,
+		},
+		{
+			name: "aws prefix",
+			tags: New(ctx, map[string]string{
+				"AWS:key": "value",
+			}),
+			wantErr: true,
+		},
+		{
+			name: "invalid key characters",
+			tags: New(ctx, map[string]string{
+				"key*": "value",
+			}),
+			wantErr: true,
+		},
+		{
+			name: "invalid value characters",
+			tags: New(ctx, map[string]string{
+				"key": "value#",
+			}),
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.tags.Validate()
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("Validate() error = %v, wantErr %t", err, want)
+			}
+		})
+	}
+}
+
 func TestNew(t *testing.T) {
 	t.Parallel()
 
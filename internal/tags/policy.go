@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+)
+
+// PolicyEnforcement determines how tag policy violations are reported.
+type PolicyEnforcement string
+
+const (
+	PolicyEnforcementError   PolicyEnforcement = "error"
+	PolicyEnforcementWarning PolicyEnforcement = "warning"
+)
+
+func (PolicyEnforcement) Values() []PolicyEnforcement {
+	return []PolicyEnforcement{
+		PolicyEnforcementError,
+		PolicyEnforcementWarning,
+	}
+}
+
+// PolicyConfig contains the tag policy that resource tags are evaluated against when planning.
+type PolicyConfig struct {
+	Enforcement         PolicyEnforcement
+	ExemptResourceTypes []string
+	Rules               []PolicyRule
+}
+
+// PolicyRule is a rule for a single tag key.
+type PolicyRule struct {
+	// AllowedValues are the patterns that the tag value must match one of. Any value is allowed if empty.
+	AllowedValues []*regexp.Regexp
+	// ExemptResourceTypes are the resource types, e.g. "aws_instance", that the rule is not applied to.
+	ExemptResourceTypes []string
+	// IgnoreCase allows the tag key to differ in case from Key.
+	IgnoreCase bool
+	Key        string
+	Required   bool
+}
+
+// Evaluate returns a description of each of the policy's rules that the specified resource type's tags violate.
+func (p *PolicyConfig) Evaluate(resourceType string, tags KeyValueTags) []string {
+	if p == nil || slices.Contains(p.ExemptResourceTypes, resourceType) {
+		return nil
+	}
+
+	var violations []string
+
+	for _, rule := range p.Rules {
+		if slices.Contains(rule.ExemptResourceTypes, resourceType) {
+			continue
+		}
+
+		violations = append(violations, rule.evaluate(tags)...)
+	}
+
+	return violations
+}
+
+func (r PolicyRule) evaluate(tags KeyValueTags) []string {
+	var violations []string
+	var found bool
+
+	for _, k := range slices.Sorted(maps.Keys(tags)) {
+		if !strings.EqualFold(k, r.Key) {
+			continue
+		}
+
+		found = true
+
+		if k != r.Key && !r.IgnoreCase {
+			violations = append(violations, fmt.Sprintf("tag key %q must be written as %q", k, r.Key))
+		}
+
+		if len(r.AllowedValues) > 0 {
+			v := tags[k].ValueString()
+			if !slices.ContainsFunc(r.AllowedValues, func(re *regexp.Regexp) bool {
+				return re.MatchString(v)
+			}) {
+				violations = append(violations, fmt.Sprintf("tag %q has value %q, which does not match any allowed value", k, v))
+			}
+		}
+	}
+
+	if !found && r.Required {
+		violations = append(violations, fmt.Sprintf("required tag %q is missing", r.Key))
+	}
+
+	return violations
+}
+
+// organizationsTagPolicy is the subset of the AWS Organizations tag policy syntax that is supported.
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_example-tag-policies.html.
+type organizationsTagPolicy struct {
+	Tags map[string]struct {
+		TagKey *struct {
+			Assign string `json:"@@assign"`
+		} `json:"tag_key"`
+		TagValue *struct {
+			Assign []string `json:"@@assign"`
+		} `json:"tag_value"`
+	} `json:"tags"`
+}
+
+// PolicyRulesFromOrganizationsTagPolicy returns tag policy rules equivalent to the tag key and tag value settings of an AWS Organizations tag policy document.
+// As in AWS Organizations, tags are not required and a `tag_key` setting enforces the capitalization of the key.
+// Other settings, such as `enforced_for`, are ignored.
+func PolicyRulesFromOrganizationsTagPolicy(document []byte) ([]PolicyRule, error) {
+	var policy organizationsTagPolicy
+	if err := json.Unmarshal(document, &policy); err != nil {
+		return nil, fmt.Errorf("parsing AWS Organizations tag policy: %w", err)
+	}
+
+	var rules []PolicyRule
+
+	for _, name := range slices.Sorted(maps.Keys(policy.Tags)) {
+		v := policy.Tags[name]
+		rule := PolicyRule{
+			IgnoreCase: true,
+			Key:        name,
+		}
+
+		if v.TagKey != nil && v.TagKey.Assign != "" {
+			rule.IgnoreCase = false
+			rule.Key = v.TagKey.Assign
+		}
+
+		if v.TagValue != nil {
+			for _, value := range v.TagValue.Assign {
+				// The only wildcard supported in tag values is '*'.
+				parts := strings.Split(value, "*")
+				for i, part := range parts {
+					parts[i] = regexp.QuoteMeta(part)
+				}
+				rule.AllowedValues = append(rule.AllowedValues, regexache.MustCompile(`^`+strings.Join(parts, `.*`)+`$`))
+			}
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPolicyConfigEvaluate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	policy := &PolicyConfig{
+		Enforcement:         PolicyEnforcementError,
+		ExemptResourceTypes: []string{"aws_exempt"},
+		Rules: []PolicyRule{
+			{
+				AllowedValues: []*regexp.Regexp{regexache.MustCompile(`^[0-9]{4}$`)},
+				Key:           "CostCenter",
+				Required:      true,
+			},
+			{
+				AllowedValues:       []*regexp.Regexp{regexache.MustCompile(`(?i)^(dev|prod)$`)},
+				ExemptResourceTypes: []string{"aws_rule_exempt"},
+				IgnoreCase:          true,
+				Key:                 "Environment",
+			},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		policy       *PolicyConfig
+		resourceType string
+		tags         map[string]string
+		want         []string
+	}{
+		{
+			name:         "nil policy",
+			resourceType: "aws_test",
+		},
+		{
+			name:         "compliant",
+			policy:       policy,
+			resourceType: "aws_test",
+			tags: map[string]string{
+				"CostCenter":  "1234",
+				"environment": "PROD",
+				"Other":       "value",
+			},
+		},
+		{
+			name:         "missing required tag",
+			policy:       policy,
+			resourceType: "aws_test",
+			tags: map[string]string{
+				"Environment": "dev",
+			},
+			want: []string{
+				`required tag "CostCenter" is missing`,
+			},
+		},
+		{
+			name:         "wrong case and value",
+			policy:       policy,
+			resourceType: "aws_test",
+			tags: map[string]string{
+				"costcenter":  "12",
+				"Environment": "test",
+			},
+			want: []string{
+				`tag key "costcenter" must be written as "CostCenter"`,
+				`tag "costcenter" has value "12", which does not match any allowed value`,
+				`tag "Environment" has value "test", which does not match any allowed value`,
+			},
+		},
+		{
+			name:         "exempt resource type",
+			policy:       policy,
+			resourceType: "aws_exempt",
+		},
+		{
+			name:         "rule exempt resource type",
+			policy:       policy,
+			resourceType: "aws_rule_exempt",
+			tags: map[string]string{
+				"CostCenter":  "1234",
+				"Environment": "test",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.policy.Evaluate(testCase.resourceType, New(ctx, testCase.tags))
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyRulesFromOrganizationsTagPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	document := `{
+  "tags": {
+    "costcenter": {
+      "tag_key": {"@@assign": "CostCenter"},
+      "tag_value": {"@@assign": ["100", "200*"]},
+      "enforced_for": {"@@assign": ["ec2:instance"]}
+    },
+    "project": {
+      "tag_value": {"@@assign": ["Alpha"]}
+    }
+  }
+}`
+
+	rules, err := PolicyRulesFromOrganizationsTagPolicy([]byte(document))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	policy := &PolicyConfig{Rules: rules}
+
+	testCases := map[string]struct {
+		tags map[string]string
+		want []string
+	}{
+		"compliant": {
+			tags: map[string]string{
+				"CostCenter": "200-a.b",
+				"PROJECT":    "Alpha",
+			},
+		},
+		"not required": {},
+		"non-compliant": {
+			tags: map[string]string{
+				"COSTCENTER": "1000",
+				"project":    "alpha",
+			},
+			want: []string{
+				`tag key "COSTCENTER" must be written as "CostCenter"`,
+				`tag "COSTCENTER" has value "1000", which does not match any allowed value`,
+				`tag "project" has value "alpha", which does not match any allowed value`,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := policy.Evaluate("aws_instance", New(ctx, testCase.tags))
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+
+	if _, err := PolicyRulesFromOrganizationsTagPolicy([]byte(`{`)); err == nil {
+		t.Error("expected error for invalid document")
+	}
+}
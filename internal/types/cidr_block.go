@@ -52,3 +52,58 @@ func CanonicalCIDRBlock(cidr string) string {
 
 	return ipnet.String()
 }
+
+// maxCIDRBlockSubnetsNewbits limits the number of subnets returned by CIDRBlockSubnets.
+const maxCIDRBlockSubnetsNewbits = 16
+
+// CIDRBlockSubnets returns all the subnets of the specified CIDR block
+// obtained by extending its prefix length by newbits.
+// The CIDR block must be valid as defined by ValidateCIDRBlock.
+func CIDRBlockSubnets(cidr string, newbits int) ([]string, error) {
+	if err := ValidateCIDRBlock(cidr); err != nil {
+		return nil, err
+	}
+
+	_, ipnet, _ := net.ParseCIDR(cidr)
+	ones, bits := ipnet.Mask.Size()
+
+	if newbits < 0 {
+		return nil, fmt.Errorf("newbits (%d) must not be negative", newbits)
+	}
+	if ones+newbits > bits {
+		return nil, fmt.Errorf("insufficient address space to extend prefix of %d by %d bits", ones, newbits)
+	}
+	if newbits > maxCIDRBlockSubnetsNewbits {
+		return nil, fmt.Errorf("newbits (%d) must not be greater than %d", newbits, maxCIDRBlockSubnetsNewbits)
+	}
+
+	n := 1 << newbits
+	subnets := make([]string, 0, n)
+	mask := net.CIDRMask(ones+newbits, bits)
+	ip := ipnet.IP
+
+	for i := 0; i < n; i++ {
+		subnets = append(subnets, (&net.IPNet{IP: ip, Mask: mask}).String())
+		ip = nextIP(ip, bits-ones-newbits)
+	}
+
+	return subnets, nil
+}
+
+// nextIP returns the IP address obtained by adding 2^hostbits to ip.
+func nextIP(ip net.IP, hostbits int) net.IP {
+	next := make(net.IP, len(ip))
+	copy(next, ip)
+
+	// Index of the byte containing the lowest network bit and the carry into it.
+	i := len(next) - 1 - hostbits/8
+	carry := 1 << (hostbits % 8)
+
+	for ; i >= 0 && carry > 0; i-- {
+		sum := int(next[i]) + carry
+		next[i] = byte(sum)
+		carry = sum >> 8
+	}
+
+	return next
+}
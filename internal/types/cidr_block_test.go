@@ -3,7 +3,10 @@
 
 package types
 
-import "testing"
+import (
+	"slices"
+	"testing"
+)
 
 func TestValidateCIDRBlock(t *testing.T) {
 	t.Parallel()
@@ -72,3 +75,40 @@ func TestCanonicalCIDRBlock(t *testing.T) {
 		}
 	}
 }
+
+func TestCIDRBlockSubnets(t *testing.T) {
+	t.Parallel()
+
+	for _, ts := range []struct {
+		cidr     string
+		newbits  int
+		expected []string
+		valid    bool
+	}{
+		{"10.0.0.0/16", 0, []string{"10.0.0.0/16"}, true},
+		{"10.0.0.0/16", 2, []string{"10.0.0.0/18", "10.0.64.0/18", "10.0.128.0/18", "10.0.192.0/18"}, true},
+		{"10.0.0.0/24", 3, []string{"10.0.0.0/27", "10.0.0.32/27", "10.0.0.64/27", "10.0.0.96/27", "10.0.0.128/27", "10.0.0.160/27", "10.0.0.192/27", "10.0.0.224/27"}, true},
+		{"255.255.255.0/24", 1, []string{"255.255.255.0/25", "255.255.255.128/25"}, true},
+		{"10.0.0.0/30", 2, []string{"10.0.0.0/32", "10.0.0.1/32", "10.0.0.2/32", "10.0.0.3/32"}, true},
+		{"2001:db8::/56", 2, []string{"2001:db8::/58", "2001:db8:0:40::/58", "2001:db8:0:80::/58", "2001:db8:0:c0::/58"}, true},
+		{"10.0.0.0/30", 3, nil, false},
+		{"10.0.0.0/16", -1, nil, false},
+		{"10.0.0.0/8", 17, nil, false},
+		{"10.0.0.1/16", 1, nil, false},
+		{"", 1, nil, false},
+	} {
+		got, err := CIDRBlockSubnets(ts.cidr, ts.newbits)
+		if !ts.valid {
+			if err == nil {
+				t.Fatalf("CIDRBlockSubnets(%q, %d) should error but didn't!", ts.cidr, ts.newbits)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("CIDRBlockSubnets(%q, %d) got unexpected error: %s", ts.cidr, ts.newbits, err)
+		}
+		if !slices.Equal(got, ts.expected) {
+			t.Fatalf("CIDRBlockSubnets(%q, %d) should be: %q, got: %q", ts.cidr, ts.newbits, ts.expected, got)
+		}
+	}
+}
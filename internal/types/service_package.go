@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -25,6 +26,40 @@ type ServicePackageFrameworkDataSource struct {
 	Tags    *ServicePackageResourceTags
 }
 
+// ServicePackageEphemeralResource represents a Terraform Plugin Framework ephemeral resource
+// implemented by a service package.
+type ServicePackageEphemeralResource struct {
+	Factory func(context.Context) (ephemeral.EphemeralResourceWithConfigure, error)
+	Name    string
+}
+
+// ServicePackageListResource represents a resource type whose instances can be
+// enumerated by a service package, for example to generate import blocks.
+type ServicePackageListResource struct {
+	List     ListResourceFunc
+	TypeName string
+	Name     string
+}
+
+// ListResourceFunc lists all instances of a resource type in the configured account and Region.
+// fn is called for each instance found and listing stops if fn returns false.
+// meta is the provider's *conns.AWSClient.
+type ListResourceFunc func(ctx context.Context, meta any, request ListResourceRequest, fn func(ListResult) bool) error
+
+// ListResourceRequest represents options for listing resource instances.
+type ListResourceRequest struct {
+	// IncludeTags indicates that each result's tags must be populated,
+	// even if that requires additional API calls.
+	IncludeTags bool
+}
+
+// ListResult represents a single resource instance found by a ListResourceFunc.
+type ListResult struct {
+	DisplayName string            // Human-readable name, e.g. the resource's "name" attribute value
+	ImportID    string            // Value to be used as an import block's "id"
+	Tags        map[string]string // Resource tags, if available
+}
+
 // ServicePackageFrameworkResource represents a Terraform Plugin Framework resource
 // implemented by a service package.
 type ServicePackageFrameworkResource struct {
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"fmt"
+
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+// Diff returns a description of each difference between the interactions of two cassettes,
+// after the requests have been normalized by the specified Normalizers.
+// Interactions are compared in order.
+func Diff(x, y *cassette.Cassette, normalizers ...Normalizer) ([]string, error) {
+	var diffs []string
+
+	if n, m := len(x.Interactions), len(y.Interactions); n != m {
+		diffs = append(diffs, fmt.Sprintf("interaction count: %d != %d", n, m))
+	}
+
+	for i := range min(len(x.Interactions), len(y.Interactions)) {
+		xi, yi := x.Interactions[i], y.Interactions[i]
+
+		xr, err := FromCassetteRequest(xi.Request)
+		if err != nil {
+			return nil, fmt.Errorf("interaction %d: %w", i, err)
+		}
+		yr, err := FromCassetteRequest(yi.Request)
+		if err != nil {
+			return nil, fmt.Errorf("interaction %d: %w", i, err)
+		}
+
+		operation := xr.Method + " " + xr.URL.Path
+		if v := xr.Header.Get("X-Amz-Target"); v != "" {
+			operation = v
+		}
+
+		for _, v := range xr.Normalize(normalizers...).Differences(yr.Normalize(normalizers...)) {
+			diffs = append(diffs, fmt.Sprintf("interaction %d (%s): request %s", i, operation, v))
+		}
+
+		if xc, yc := xi.Response.Code, yi.Response.Code; xc != yc {
+			diffs = append(diffs, fmt.Sprintf("interaction %d (%s): response code: %d != %d", i, operation, xc, yc))
+		}
+	}
+
+	for i := len(y.Interactions); i < len(x.Interactions); i++ {
+		diffs = append(diffs, fmt.Sprintf("interaction %d: only in %s", i, x.Name))
+	}
+	for i := len(x.Interactions); i < len(y.Interactions); i++ {
+		diffs = append(diffs, fmt.Sprintf("interaction %d: only in %s", i, y.Name))
+	}
+
+	return diffs, nil
+}
@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+// NewMatcher returns a cassette.MatcherFunc which matches a live request to a recorded request
+// if they are equal after both have been normalized by the specified Normalizers.
+// Method, URL, operation-selecting headers (e.g. X-Amz-Target) and body are compared.
+func NewMatcher(ctx context.Context, normalizers ...Normalizer) cassette.MatcherFunc {
+	return func(r *http.Request, i cassette.Request) bool {
+		live, err := FromHTTPRequest(r)
+		if err != nil {
+			tflog.Debug(ctx, "Failed to read request", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		recorded, err := FromCassetteRequest(i)
+		if err != nil {
+			tflog.Debug(ctx, "Failed to read recorded request", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		return live.Normalize(normalizers...).Equal(recorded.Normalize(normalizers...))
+	}
+}
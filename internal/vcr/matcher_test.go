@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+func TestMatcher(t *testing.T) {
+	t.Parallel()
+
+	const url = "https://ec2.us-west-2.amazonaws.com/"
+
+	testCases := map[string]struct {
+		live     func() *http.Request
+		recorded cassette.Request
+		expected bool
+	}{
+		"JSON reordered, volatile fields differ": {
+			live: func() *http.Request {
+				r, _ := http.NewRequest(http.MethodPost, "https://logs.us-west-2.amazonaws.com/", strings.NewReader(`{"logGroupName":"tf-acc","clientToken":"aaaa","startTime":"2024-01-02T03:04:05Z"}`))
+				r.Header.Set("Content-Type", "application/x-amz-json-1.1")
+				r.Header.Set("X-Amz-Target", "Logs_20140328.CreateLogGroup")
+				r.Header.Set("X-Amz-Date", "20240102T030405Z")
+				r.Header.Set("Authorization", "AWS4-HMAC-SHA256 ...")
+				r.Header.Set("Amz-Sdk-Invocation-Id", "1111")
+				return r
+			},
+			recorded: cassette.Request{
+				Method: http.MethodPost,
+				URL:    "https://logs.us-west-2.amazonaws.com/",
+				Body:   `{"clientToken":"bbbb","startTime":"2024-05-06T07:08:09.123Z","logGroupName":"tf-acc"}`,
+				Headers: http.Header{
+					"Content-Type":          {"application/x-amz-json-1.1"},
+					"X-Amz-Target":          {"Logs_20140328.CreateLogGroup"},
+					"X-Amz-Date":            {"20240506T070809Z"},
+					"Amz-Sdk-Invocation-Id": {"2222"},
+				},
+			},
+			expected: true,
+		},
+		"different operation": {
+			live: func() *http.Request {
+				r, _ := http.NewRequest(http.MethodPost, "https://logs.us-west-2.amazonaws.com/", strings.NewReader(`{}`))
+				r.Header.Set("Content-Type", "application/x-amz-json-1.1")
+				r.Header.Set("X-Amz-Target", "Logs_20140328.CreateLogGroup")
+				return r
+			},
+			recorded: cassette.Request{
+				Method: http.MethodPost,
+				URL:    "https://logs.us-west-2.amazonaws.com/",
+				Body:   `{}`,
+				Headers: http.Header{
+					"Content-Type": {"application/x-amz-json-1.1"},
+					"X-Amz-Target": {"Logs_20140328.DeleteLogGroup"},
+				},
+			},
+			expected: false,
+		},
+		"query protocol reordered": {
+			live: func() *http.Request {
+				r, _ := http.NewRequest(http.MethodPost, url, strings.NewReader("Action=RunInstances&ClientToken=aaaa&Version=2016-11-15"))
+				r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+				return r
+			},
+			recorded: cassette.Request{
+				Method:  http.MethodPost,
+				URL:     url,
+				Body:    "Version=2016-11-15&ClientToken=bbbb&Action=RunInstances",
+				Headers: http.Header{"Content-Type": {"application/x-www-form-urlencoded"}},
+			},
+			expected: true,
+		},
+		"query protocol different value": {
+			live: func() *http.Request {
+				r, _ := http.NewRequest(http.MethodPost, url, strings.NewReader("Action=RunInstances&InstanceType=t3.micro"))
+				r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+				return r
+			},
+			recorded: cassette.Request{
+				Method:  http.MethodPost,
+				URL:     url,
+				Body:    "Action=RunInstances&InstanceType=t3.large",
+				Headers: http.Header{"Content-Type": {"application/x-www-form-urlencoded"}},
+			},
+			expected: false,
+		},
+		"XML indentation and caller reference": {
+			live: func() *http.Request {
+				r, _ := http.NewRequest(http.MethodPost, "https://route53.amazonaws.com/2013-04-01/hostedzone", strings.NewReader(`<CreateHostedZoneRequest><Name>example.com</Name><CallerReference>aaaa</CallerReference></CreateHostedZoneRequest>`))
+				r.Header.Set("Content-Type", "application/xml")
+				return r
+			},
+			recorded: cassette.Request{
+				Method: http.MethodPost,
+				URL:    "https://route53.amazonaws.com/2013-04-01/hostedzone",
+				Body: `<CreateHostedZoneRequest>
+  <Name>example.com</Name>
+  <CallerReference>bbbb</CallerReference>
+</CreateHostedZoneRequest>`,
+				Headers: http.Header{"Content-Type": {"application/xml"}},
+			},
+			expected: true,
+		},
+		"presigned query parameters": {
+			live: func() *http.Request {
+				r, _ := http.NewRequest(http.MethodGet, "https://bucket.s3.amazonaws.com/key?X-Amz-Signature=aaaa&X-Amz-Date=20240102T030405Z&versionId=1", nil)
+				return r
+			},
+			recorded: cassette.Request{
+				Method: http.MethodGet,
+				URL:    "https://bucket.s3.amazonaws.com/key?versionId=1&X-Amz-Signature=bbbb&X-Amz-Date=20240506T070809Z",
+			},
+			expected: true,
+		},
+		"different path": {
+			live: func() *http.Request {
+				r, _ := http.NewRequest(http.MethodGet, "https://bucket.s3.amazonaws.com/key1", nil)
+				return r
+			},
+			recorded: cassette.Request{
+				Method: http.MethodGet,
+				URL:    "https://bucket.s3.amazonaws.com/key2",
+			},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			matcher := vcr.NewMatcher(context.Background(), vcr.Normalizers()...)
+			r := testCase.live()
+
+			if got, want := matcher(r, testCase.recorded), testCase.expected; got != want {
+				t.Errorf("matched = %t, want %t", got, want)
+			}
+
+			// The live request's body must still be readable.
+			if r.Body != nil && r.Body != http.NoBody {
+				if _, err := io.ReadAll(r.Body); err != nil {
+					t.Errorf("reading body: %s", err)
+				}
+			}
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	interaction := func(target, body string, code int) *cassette.Interaction {
+		return &cassette.Interaction{
+			Request: cassette.Request{
+				Method: http.MethodPost,
+				URL:    "https://logs.us-west-2.amazonaws.com/",
+				Body:   body,
+				Headers: http.Header{
+					"Content-Type": {"application/x-amz-json-1.1"},
+					"X-Amz-Target": {target},
+				},
+			},
+			Response: cassette.Response{
+				Code: code,
+			},
+		}
+	}
+
+	x := &cassette.Cassette{
+		Name: "x",
+		Interactions: []*cassette.Interaction{
+			interaction("Logs_20140328.CreateLogGroup", `{"logGroupName":"a"}`, http.StatusOK),
+			interaction("Logs_20140328.DescribeLogGroups", `{"logGroupNamePrefix":"a"}`, http.StatusOK),
+			interaction("Logs_20140328.DeleteLogGroup", `{"logGroupName":"a"}`, http.StatusOK),
+		},
+	}
+	y := &cassette.Cassette{
+		Name: "y",
+		Interactions: []*cassette.Interaction{
+			interaction("Logs_20140328.CreateLogGroup", `{"logGroupName":"a","clientToken":"x"}`, http.StatusOK),
+			interaction("Logs_20140328.DescribeLogGroups", `{"logGroupNamePrefix":"b"}`, http.StatusBadRequest),
+		},
+	}
+
+	diffs, err := vcr.Diff(x, y, vcr.Normalizers()...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Interaction 0 differs only in the (added) client token member, which isn't normalized away as it's missing from x.
+	want := []string{
+		"interaction count: 3 != 2",
+		`interaction 0 (Logs_20140328.CreateLogGroup): request body: {"logGroupName":"a"} != {"clientToken":"<token>","logGroupName":"a"}`,
+		`interaction 1 (Logs_20140328.DescribeLogGroups): request body: {"logGroupNamePrefix":"a"} != {"logGroupNamePrefix":"b"}`,
+		"interaction 1 (Logs_20140328.DescribeLogGroups): response code: 200 != 400",
+		"interaction 2: only in x",
+	}
+
+	if got := strings.Join(diffs, "\n"); got != strings.Join(want, "\n") {
+		t.Errorf("got:\n%s\nwant:\n%s", got, strings.Join(want, "\n"))
+	}
+
+	diffs, err = vcr.Diff(x, x, vcr.Normalizers()...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diffs) != 0 {
+		t.Errorf("identical cassettes: %v", diffs)
+	}
+}
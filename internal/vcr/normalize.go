@@ -0,0 +1,264 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"encoding/json"
+	"html"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/YakDriver/regexache"
+)
+
+// Normalizer normalizes volatile parts of a request in place, so that requests
+// which differ only in those parts compare equal.
+type Normalizer func(*Request)
+
+const (
+	normalizedToken     = "<token>"
+	normalizedTimestamp = "<timestamp>"
+)
+
+var (
+	normalizersLock sync.Mutex
+	normalizers     = []Normalizer{
+		NormalizeSigV4,
+		NormalizeRequestIDs,
+		NormalizeIdempotencyTokens,
+		NormalizeTimestamps,
+	}
+)
+
+// RegisterNormalizer adds a Normalizer to those returned by Normalizers.
+// It is typically called from a service package's test init function to handle service-specific volatile fields.
+func RegisterNormalizer(n Normalizer) {
+	normalizersLock.Lock()
+	defer normalizersLock.Unlock()
+
+	normalizers = append(normalizers, n)
+}
+
+// Normalizers returns the default Normalizers and any registered with RegisterNormalizer.
+func Normalizers() []Normalizer {
+	normalizersLock.Lock()
+	defer normalizersLock.Unlock()
+
+	return slices.Clone(normalizers)
+}
+
+var (
+	sigV4Headers = []string{
+		"Amz-Sdk-Invocation-Id",
+		"Amz-Sdk-Request",
+		"Authorization",
+		"User-Agent",
+		"X-Amz-Content-Sha256",
+		"X-Amz-Date",
+		"X-Amz-Security-Token",
+		"X-Amz-User-Agent",
+	}
+	sigV4QueryParameters = []string{
+		"X-Amz-Algorithm",
+		"X-Amz-Credential",
+		"X-Amz-Date",
+		"X-Amz-Expires",
+		"X-Amz-Security-Token",
+		"X-Amz-Signature",
+		"X-Amz-SignedHeaders",
+	}
+)
+
+// NormalizeSigV4 removes Signature Version 4 signing headers and presigned URL query parameters,
+// along with the SDK's per-invocation headers.
+func NormalizeSigV4(r *Request) {
+	for _, v := range sigV4Headers {
+		r.Header.Del(v)
+	}
+
+	if r.URL != nil {
+		query := r.URL.Query()
+		for _, v := range sigV4QueryParameters {
+			query.Del(v)
+		}
+		r.URL.RawQuery = query.Encode()
+	}
+}
+
+var requestIDHeaderRegexp = regexache.MustCompile(`(?i)^x-amzn?-(.+-)?(id|id-2|requestid|trace-id)$`)
+
+// NormalizeRequestIDs removes x-amz-* and x-amzn-* request, trace and client ID headers.
+func NormalizeRequestIDs(r *Request) {
+	for k := range r.Header {
+		if requestIDHeaderRegexp.MatchString(k) {
+			r.Header.Del(k)
+		}
+	}
+}
+
+// idempotencyTokenNames are the (case-insensitive) names of request members holding idempotency tokens.
+var idempotencyTokenNames = []string{
+	"callerreference",
+	"clientrequesttoken",
+	"clienttoken",
+	"idempotencytoken",
+	"requesttoken",
+}
+
+func isIdempotencyTokenName(name string) bool {
+	return slices.Contains(idempotencyTokenNames, strings.ToLower(name))
+}
+
+var idempotencyTokenXMLRegexp = regexache.MustCompile(`(?i)<(CallerReference|ClientRequestToken|ClientToken|IdempotencyToken|RequestToken)>[^<]*</`)
+
+// NormalizeIdempotencyTokens replaces the values of idempotency token request members
+// (e.g. ClientToken, ClientRequestToken, CallerReference) in JSON, query and XML request bodies and in the URL query.
+func NormalizeIdempotencyTokens(r *Request) {
+	if r.URL != nil {
+		query := r.URL.Query()
+		for k := range query {
+			if isIdempotencyTokenName(k) {
+				query.Set(k, normalizedToken)
+			}
+		}
+		r.URL.RawQuery = query.Encode()
+	}
+
+	switch r.bodyFormat() {
+	case bodyFormatJSON:
+		r.transformJSON(func(v any) any {
+			return replaceJSONMembers(v, isIdempotencyTokenName, normalizedToken)
+		})
+	case bodyFormatForm:
+		r.transformForm(func(values url.Values) {
+			for k := range values {
+				// Query protocol members may be nested, e.g. "Foo.1.ClientToken".
+				if isIdempotencyTokenName(k[strings.LastIndex(k, ".")+1:]) {
+					values.Set(k, normalizedToken)
+				}
+			}
+		})
+	case bodyFormatXML:
+		r.Body = idempotencyTokenXMLRegexp.ReplaceAllString(r.Body, "<${1}>"+html.EscapeString(normalizedToken)+"</")
+	}
+}
+
+var timestampRegexp = regexache.MustCompile(`\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:?\d{2})?`)
+
+// NormalizeTimestamps replaces ISO 8601 timestamps in the request body and URL query.
+func NormalizeTimestamps(r *Request) {
+	if r.URL != nil {
+		query := r.URL.Query()
+		for k, v := range query {
+			for i := range v {
+				v[i] = timestampRegexp.ReplaceAllString(v[i], normalizedTimestamp)
+			}
+			query[k] = v
+		}
+		r.URL.RawQuery = query.Encode()
+	}
+
+	switch r.bodyFormat() {
+	case bodyFormatJSON:
+		r.transformJSON(func(v any) any {
+			return transformJSONStrings(v, func(s string) string {
+				return timestampRegexp.ReplaceAllString(s, normalizedTimestamp)
+			})
+		})
+	case bodyFormatForm:
+		r.transformForm(func(values url.Values) {
+			for k, v := range values {
+				for i := range v {
+					v[i] = timestampRegexp.ReplaceAllString(v[i], normalizedTimestamp)
+				}
+				values[k] = v
+			}
+		})
+	case bodyFormatXML:
+		r.Body = timestampRegexp.ReplaceAllString(r.Body, html.EscapeString(normalizedTimestamp))
+	default:
+		r.Body = timestampRegexp.ReplaceAllString(r.Body, normalizedTimestamp)
+	}
+}
+
+// transformJSON replaces the body with the JSON encoding of f applied to the decoded body.
+// The body is left unchanged if it is not valid JSON.
+func (r *Request) transformJSON(f func(any) any) {
+	var v any
+	if err := json.Unmarshal([]byte(r.Body), &v); err != nil {
+		return
+	}
+
+	var b strings.Builder
+	encoder := json.NewEncoder(&b)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(f(v)); err != nil {
+		return
+	}
+
+	r.Body = strings.TrimSuffix(b.String(), "\n")
+}
+
+// transformForm replaces the body with the encoding of the form values after f has modified them.
+// The body is left unchanged if it cannot be parsed.
+func (r *Request) transformForm(f func(url.Values)) {
+	values, err := url.ParseQuery(r.Body)
+	if err != nil {
+		return
+	}
+
+	f(values)
+
+	r.Body = values.Encode()
+}
+
+func replaceJSONMembers(v any, match func(string) bool, replacement string) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if match(k) {
+				v[k] = replacement
+			} else {
+				v[k] = replaceJSONMembers(e, match, replacement)
+			}
+		}
+	case []any:
+		for i, e := range v {
+			v[i] = replaceJSONMembers(e, match, replacement)
+		}
+	}
+
+	return v
+}
+
+func transformJSONStrings(v any, f func(string) string) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			v[k] = transformJSONStrings(e, f)
+		}
+	case []any:
+		for i, e := range v {
+			v[i] = transformJSONStrings(e, f)
+		}
+	case string:
+		return f(v)
+	}
+
+	return v
+}
+
+// headerNames returns the canonical names of the specified headers.
+func headerNames(h http.Header) []string {
+	names := make([]string, 0, len(h))
+	for k := range h {
+		names = append(names, http.CanonicalHeaderKey(k))
+	}
+	slices.Sort(names)
+
+	return names
+}
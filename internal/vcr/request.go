@@ -0,0 +1,248 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"reflect"
+	"slices"
+	"strings"
+
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+// Request is an HTTP request in the form used for matching and comparison.
+type Request struct {
+	Method string
+	URL    *url.URL
+	Header http.Header
+	Body   string
+}
+
+// FromHTTPRequest returns a Request for a live HTTP request.
+// The request's body is read and replaced so that it can be read again.
+func FromHTTPRequest(r *http.Request) (*Request, error) {
+	request := &Request{
+		Method: r.Method,
+		URL:    cloneURL(r.URL),
+		Header: r.Header.Clone(),
+	}
+
+	if request.Header == nil {
+		request.Header = make(http.Header)
+	}
+
+	if r.Body != nil && r.Body != http.NoBody {
+		var b bytes.Buffer
+		if _, err := b.ReadFrom(r.Body); err != nil {
+			return nil, fmt.Errorf("reading request body: %w", err)
+		}
+		r.Body = io.NopCloser(bytes.NewReader(b.Bytes()))
+		request.Body = b.String()
+	}
+
+	return request, nil
+}
+
+// FromCassetteRequest returns a Request for a recorded request.
+func FromCassetteRequest(r cassette.Request) (*Request, error) {
+	u, err := url.Parse(r.URL)
+	if err != nil {
+		return nil, fmt.Errorf("parsing recorded request URL: %w", err)
+	}
+
+	request := &Request{
+		Method: r.Method,
+		URL:    u,
+		Header: r.Headers.Clone(),
+		Body:   r.Body,
+	}
+
+	if request.Header == nil {
+		request.Header = make(http.Header)
+	}
+
+	return request, nil
+}
+
+// Normalize applies the specified Normalizers to the request.
+func (r *Request) Normalize(normalizers ...Normalizer) *Request {
+	for _, n := range normalizers {
+		n(r)
+	}
+
+	return r
+}
+
+type bodyFormat int
+
+const (
+	bodyFormatOther bodyFormat = iota
+	bodyFormatJSON
+	bodyFormatForm
+	bodyFormatXML
+)
+
+// bodyFormat returns the format of the request body.
+// See https://smithy.io/2.0/aws/protocols/index.html.
+func (r *Request) bodyFormat() bodyFormat {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+
+	switch {
+	case mediaType == "application/json", strings.HasPrefix(mediaType, "application/x-amz-json-"):
+		return bodyFormatJSON
+	case mediaType == "application/x-www-form-urlencoded":
+		return bodyFormatForm
+	case mediaType == "application/xml", mediaType == "text/xml":
+		return bodyFormatXML
+	}
+
+	return bodyFormatOther
+}
+
+// matchedHeader returns whether a header is compared when matching requests.
+// Only headers which select the operation or carry request data are compared.
+func matchedHeader(name string) bool {
+	name = http.CanonicalHeaderKey(name)
+
+	return name == "Content-Type" || strings.HasPrefix(name, "X-Amz-")
+}
+
+// Differences returns a description of each difference between two (normalized) requests.
+// Bodies are compared semantically according to their content type.
+func (r *Request) Differences(other *Request) []string {
+	var diffs []string
+
+	if r.Method != other.Method {
+		diffs = append(diffs, fmt.Sprintf("method: %s != %s", r.Method, other.Method))
+	}
+
+	if !equalURLs(r.URL, other.URL) {
+		diffs = append(diffs, fmt.Sprintf("URL: %s != %s", r.URL, other.URL))
+	}
+
+	names := slices.Concat(headerNames(r.Header), headerNames(other.Header))
+	slices.Sort(names)
+	for _, name := range slices.Compact(names) {
+		if !matchedHeader(name) {
+			continue
+		}
+
+		if x, y := r.Header.Values(name), other.Header.Values(name); !slices.Equal(x, y) {
+			diffs = append(diffs, fmt.Sprintf("header %s: %q != %q", name, x, y))
+		}
+	}
+
+	if equal, err := equalBodies(r.bodyFormat(), r.Body, other.Body); err != nil {
+		diffs = append(diffs, fmt.Sprintf("body: %s", err))
+	} else if !equal {
+		diffs = append(diffs, fmt.Sprintf("body: %s != %s", r.Body, other.Body))
+	}
+
+	return diffs
+}
+
+// Equal returns whether two (normalized) requests match.
+func (r *Request) Equal(other *Request) bool {
+	return len(r.Differences(other)) == 0
+}
+
+func equalURLs(x, y *url.URL) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+
+	return x.Scheme == y.Scheme && x.Host == y.Host && x.EscapedPath() == y.EscapedPath() && reflect.DeepEqual(x.Query(), y.Query())
+}
+
+func equalBodies(format bodyFormat, x, y string) (bool, error) {
+	if x == y {
+		return true, nil
+	}
+
+	switch format {
+	case bodyFormatJSON:
+		// JSON might be the same, but reordered.
+		var xv, yv any
+
+		if err := json.Unmarshal([]byte(x), &xv); err != nil {
+			return false, fmt.Errorf("unmarshaling JSON: %w", err)
+		}
+		if err := json.Unmarshal([]byte(y), &yv); err != nil {
+			return false, fmt.Errorf("unmarshaling JSON: %w", err)
+		}
+
+		return reflect.DeepEqual(xv, yv), nil
+
+	case bodyFormatForm:
+		// Query protocol parameters might be the same, but reordered.
+		xv, err := url.ParseQuery(x)
+		if err != nil {
+			return false, fmt.Errorf("parsing query: %w", err)
+		}
+		yv, err := url.ParseQuery(y)
+		if err != nil {
+			return false, fmt.Errorf("parsing query: %w", err)
+		}
+
+		return reflect.DeepEqual(xv, yv), nil
+
+	case bodyFormatXML:
+		// XML might be the same, but differently indented.
+		xv, err := xmlTokens(x)
+		if err != nil {
+			return false, fmt.Errorf("parsing XML: %w", err)
+		}
+		yv, err := xmlTokens(y)
+		if err != nil {
+			return false, fmt.Errorf("parsing XML: %w", err)
+		}
+
+		return reflect.DeepEqual(xv, yv), nil
+	}
+
+	return false, nil
+}
+
+// xmlTokens returns the XML document's tokens, ignoring whitespace-only character data.
+func xmlTokens(s string) ([]xml.Token, error) {
+	var tokens []xml.Token
+
+	decoder := xml.NewDecoder(strings.NewReader(s))
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if v, ok := token.(xml.CharData); ok && len(bytes.TrimSpace(v)) == 0 {
+			continue
+		}
+
+		tokens = append(tokens, xml.CopyToken(token))
+	}
+
+	return tokens, nil
+}
+
+func cloneURL(u *url.URL) *url.URL {
+	if u == nil {
+		return nil
+	}
+
+	v := *u
+
+	return &v
+}
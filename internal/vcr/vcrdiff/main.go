@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+var (
+	raw = flag.Bool("raw", false, "Compare requests without normalizing volatile fields")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tgo run ./internal/vcr/vcrdiff [-raw] <cassette> <cassette>\n\n")
+	fmt.Fprintf(os.Stderr, "Compares the interactions recorded in two VCR cassettes, e.g. before and after re-recording.\n")
+	fmt.Fprintf(os.Stderr, "Exits with status 1 if there are differences.\n\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() != 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	x, err := load(flag.Arg(0))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	y, err := load(flag.Arg(1))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	var normalizers []vcr.Normalizer
+	if !*raw {
+		normalizers = vcr.Normalizers()
+	}
+
+	diffs, err := vcr.Diff(x, y, normalizers...)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	for _, v := range diffs {
+		fmt.Println(v)
+	}
+
+	if len(diffs) > 0 {
+		os.Exit(1)
+	}
+}
+
+// load loads a cassette from the specified file. The ".yaml" extension is optional.
+func load(path string) (*cassette.Cassette, error) {
+	c, err := cassette.Load(strings.TrimSuffix(path, ".yaml"))
+	if err != nil {
+		return nil, fmt.Errorf("loading cassette (%s): %w", path, err)
+	}
+
+	return c, nil
+}
@@ -10,6 +10,7 @@ import (
 	"runtime/debug"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
@@ -47,4 +48,8 @@ func main() {
 	if err != nil {
 		log.Fatal(err)
 	}
+
+	if err := conns.WriteAPIMetrics(context.Background()); err != nil {
+		log.Printf("[WARN] %s", err)
+	}
 }
@@ -0,0 +1,418 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package apimodel describes the shapes of an AWS SDK for Go v2 service package's operations
+// by introspecting the package's Go source.
+package apimodel
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	sdkServicePathPrefix = "github.com/aws/aws-sdk-go-v2/service/"
+	requiredMarker       = "This member is required."
+)
+
+// Kind is the kind of a shape member's type.
+type Kind int
+
+const (
+	KindUnknown Kind = iota
+	KindString
+	KindBool
+	KindInt32
+	KindInt64
+	KindFloat32
+	KindFloat64
+	KindTimestamp
+	KindBlob
+	KindEnum
+	KindStruct
+	KindList
+	KindMap
+	KindDocument
+	KindUnion
+)
+
+// Type describes the Go type of a shape member.
+type Type struct {
+	Kind Kind
+	Name string // Enum, structure or union type name in the SDK types package, e.g. "ClusterStatus".
+	Elem *Type  // List or map element type.
+}
+
+// Member is a member of a structure shape.
+type Member struct {
+	Name     string
+	Type     *Type
+	Required bool
+}
+
+// Struct is a structure shape.
+type Struct struct {
+	Name    string
+	Members []*Member
+}
+
+// Member returns the member with the specified name.
+func (s *Struct) Member(name string) (*Member, bool) {
+	for _, m := range s.Members {
+		if m.Name == name {
+			return m, true
+		}
+	}
+	return nil, false
+}
+
+// Enum is a string enumeration shape.
+type Enum struct {
+	Name   string
+	Values []EnumValue
+}
+
+// EnumValue is a value of a string enumeration shape.
+type EnumValue struct {
+	Name  string // Go constant name, e.g. "ClusterStatusActive".
+	Value string // e.g. "ACTIVE".
+}
+
+// Service describes an AWS SDK for Go v2 service package.
+type Service struct {
+	Package    string // e.g. "neptunegraph".
+	enums      map[string]*Enum
+	operations map[string]bool
+	paginators map[string]bool
+	shapes     map[string]*Struct // Operation input and output structures.
+	types      map[string]*Struct // Structures in the types package.
+	unions     map[string]bool
+}
+
+// Load loads the specified AWS SDK for Go v2 service package (e.g. "neptunegraph").
+// The package's source is located using the Go module of the current working directory.
+func Load(sdkPackage string) (*Service, error) {
+	output, err := exec.Command("go", "list", "-f", "{{.Dir}}", sdkServicePathPrefix+sdkPackage).Output()
+
+	if err != nil {
+		return nil, fmt.Errorf("locating AWS SDK for Go v2 package %q: %w", sdkPackage, err)
+	}
+
+	return LoadDir(sdkPackage, strings.TrimSpace(string(output)))
+}
+
+// LoadDir loads the AWS SDK for Go v2 service package with source in the specified directory.
+func LoadDir(sdkPackage, dir string) (*Service, error) {
+	s := &Service{
+		Package:    sdkPackage,
+		enums:      make(map[string]*Enum),
+		operations: make(map[string]bool),
+		paginators: make(map[string]bool),
+		shapes:     make(map[string]*Struct),
+		types:      make(map[string]*Struct),
+		unions:     make(map[string]bool),
+	}
+
+	typesFiles, err := parseDir(filepath.Join(dir, "types"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := parseDir(dir)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Enumerations and unions must be known before structure members are resolved.
+	typesStructs := make(map[string]*ast.StructType)
+	for _, file := range typesFiles {
+		for _, decl := range file.Decls {
+			decl, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+
+			switch decl.Tok {
+			case token.TYPE:
+				for _, spec := range decl.Specs {
+					spec := spec.(*ast.TypeSpec)
+
+					switch t := spec.Type.(type) {
+					case *ast.Ident:
+						if t.Name == "string" {
+							if _, ok := s.enums[spec.Name.Name]; !ok {
+								s.enums[spec.Name.Name] = &Enum{Name: spec.Name.Name}
+							}
+						}
+					case *ast.InterfaceType:
+						s.unions[spec.Name.Name] = true
+					case *ast.StructType:
+						typesStructs[spec.Name.Name] = t
+					}
+				}
+			}
+		}
+	}
+
+	for _, file := range typesFiles {
+		for _, decl := range file.Decls {
+			decl, ok := decl.(*ast.GenDecl)
+			if !ok || decl.Tok != token.CONST {
+				continue
+			}
+
+			for _, spec := range decl.Specs {
+				spec := spec.(*ast.ValueSpec)
+				ident, ok := spec.Type.(*ast.Ident)
+				if !ok {
+					continue
+				}
+				enum, ok := s.enums[ident.Name]
+				if !ok {
+					continue
+				}
+
+				for i, name := range spec.Names {
+					if i >= len(spec.Values) {
+						break
+					}
+					if lit, ok := spec.Values[i].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+						if v, err := strconv.Unquote(lit.Value); err == nil {
+							enum.Values = append(enum.Values, EnumValue{Name: name.Name, Value: v})
+						}
+					}
+				}
+			}
+		}
+	}
+
+	for name, t := range typesStructs {
+		s.types[name] = s.newStruct(name, t)
+	}
+
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if decl.Recv != nil {
+					if isClientReceiver(decl.Recv) {
+						s.operations[decl.Name.Name] = true
+					}
+					continue
+				}
+
+				if name, ok := strings.CutPrefix(decl.Name.Name, "New"); ok {
+					if name, ok := strings.CutSuffix(name, "Paginator"); ok {
+						s.paginators[name] = true
+					}
+				}
+			case *ast.GenDecl:
+				if decl.Tok != token.TYPE {
+					continue
+				}
+
+				for _, spec := range decl.Specs {
+					spec := spec.(*ast.TypeSpec)
+					t, ok := spec.Type.(*ast.StructType)
+					if !ok {
+						continue
+					}
+
+					if name := spec.Name.Name; strings.HasSuffix(name, "Input") || strings.HasSuffix(name, "Output") {
+						s.shapes[name] = s.newStruct(name, t)
+					}
+				}
+			}
+		}
+	}
+
+	return s, nil
+}
+
+// Operation returns the input and output structures of the specified operation.
+func (s *Service) Operation(name string) (*Struct, *Struct, error) {
+	if !s.operations[name] {
+		return nil, nil, fmt.Errorf("operation %q not found in AWS SDK for Go v2 package %q", name, s.Package)
+	}
+
+	input, ok := s.shapes[name+"Input"]
+	if !ok {
+		return nil, nil, fmt.Errorf("input of operation %q not found", name)
+	}
+
+	output, ok := s.shapes[name+"Output"]
+	if !ok {
+		return nil, nil, fmt.Errorf("output of operation %q not found", name)
+	}
+
+	return input, output, nil
+}
+
+// HasPaginator returns whether the specified operation has a paginator.
+func (s *Service) HasPaginator(operation string) bool {
+	return s.paginators[operation]
+}
+
+// Struct returns the structure with the specified name from the types package.
+func (s *Service) Struct(name string) (*Struct, bool) {
+	v, ok := s.types[name]
+	return v, ok
+}
+
+// Enum returns the enumeration with the specified name from the types package.
+func (s *Service) Enum(name string) (*Enum, bool) {
+	v, ok := s.enums[name]
+	return v, ok
+}
+
+func (s *Service) newStruct(name string, t *ast.StructType) *Struct {
+	v := &Struct{
+		Name: name,
+	}
+
+	for _, field := range t.Fields.List {
+		typ := s.resolveType(field.Type)
+		if typ == nil {
+			// e.g. ResultMetadata or noSmithyDocumentSerde.
+			continue
+		}
+
+		required := field.Doc != nil && strings.Contains(field.Doc.Text(), requiredMarker)
+
+		for _, ident := range field.Names {
+			if !ident.IsExported() {
+				continue
+			}
+
+			v.Members = append(v.Members, &Member{
+				Name:     ident.Name,
+				Type:     typ,
+				Required: required,
+			})
+		}
+	}
+
+	return v
+}
+
+// resolveType returns the type of a structure member, or nil if the member's type is not a shape.
+func (s *Service) resolveType(expr ast.Expr) *Type {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		return s.resolveType(expr.X)
+
+	case *ast.Ident:
+		switch expr.Name {
+		case "string":
+			return &Type{Kind: KindString}
+		case "bool":
+			return &Type{Kind: KindBool}
+		case "int32":
+			return &Type{Kind: KindInt32}
+		case "int64":
+			return &Type{Kind: KindInt64}
+		case "float32":
+			return &Type{Kind: KindFloat32}
+		case "float64":
+			return &Type{Kind: KindFloat64}
+		}
+
+		// Types package declarations are referenced unqualified from within the types package.
+		return s.resolveNamedType(expr.Name)
+
+	case *ast.SelectorExpr:
+		pkg, ok := expr.X.(*ast.Ident)
+		if !ok {
+			return nil
+		}
+
+		switch pkg.Name {
+		case "types":
+			return s.resolveNamedType(expr.Sel.Name)
+		case "time":
+			if expr.Sel.Name == "Time" {
+				return &Type{Kind: KindTimestamp}
+			}
+		case "document":
+			return &Type{Kind: KindDocument}
+		}
+
+	case *ast.ArrayType:
+		if ident, ok := expr.Elt.(*ast.Ident); ok && ident.Name == "byte" {
+			return &Type{Kind: KindBlob}
+		}
+		if elem := s.resolveType(expr.Elt); elem != nil {
+			return &Type{Kind: KindList, Elem: elem}
+		}
+
+	case *ast.MapType:
+		if elem := s.resolveType(expr.Value); elem != nil {
+			return &Type{Kind: KindMap, Elem: elem}
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) resolveNamedType(name string) *Type {
+	if _, ok := s.enums[name]; ok {
+		return &Type{Kind: KindEnum, Name: name}
+	}
+	if s.unions[name] {
+		return &Type{Kind: KindUnion, Name: name}
+	}
+	if ast.IsExported(name) {
+		return &Type{Kind: KindStruct, Name: name}
+	}
+	return nil
+}
+
+func isClientReceiver(recv *ast.FieldList) bool {
+	if len(recv.List) != 1 {
+		return false
+	}
+
+	star, ok := recv.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+
+	ident, ok := star.X.(*ast.Ident)
+
+	return ok && ident.Name == "Client"
+}
+
+// parseDir parses the non-test Go files in the specified directory.
+func parseDir(dir string) ([]*ast.File, error) {
+	filenames, err := filepath.Glob(filepath.Join(dir, "*.go"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var files []*ast.File
+	fset := token.NewFileSet()
+	for _, filename := range filenames {
+		if strings.HasSuffix(filename, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filename, nil, parser.ParseComments|parser.SkipObjectResolution)
+
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", filename, err)
+		}
+
+		files = append(files, file)
+	}
+
+	return files, nil
+}
@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimodel
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func testService(t *testing.T) *Service {
+	t.Helper()
+
+	s, err := LoadDir("example", "testdata/example")
+	if err != nil {
+		t.Fatalf("loading test service: %s", err)
+	}
+
+	return s
+}
+
+func TestLoadDir(t *testing.T) {
+	s := testService(t)
+
+	input, output, err := s.Operation("CreateWidget")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	member, ok := input.Member("WidgetName")
+	if !ok {
+		t.Fatal("WidgetName member not found")
+	}
+	if !member.Required {
+		t.Error("WidgetName should be required")
+	}
+
+	member, ok = input.Member("Size")
+	if !ok {
+		t.Fatal("Size member not found")
+	}
+	if member.Required {
+		t.Error("Size should not be required")
+	}
+	if got, want := *member.Type, (Type{Kind: KindEnum, Name: "Size"}); got != want {
+		t.Errorf("Size type = %+v, want %+v", got, want)
+	}
+
+	if _, ok := output.Member("noSmithyDocumentSerde"); ok {
+		t.Error("unexported members should be ignored")
+	}
+
+	if _, _, err := s.Operation("DescribeWidget"); err == nil {
+		t.Error("expected error for unknown operation")
+	}
+
+	if !s.HasPaginator("ListWidgets") {
+		t.Error("ListWidgets should have a paginator")
+	}
+	if s.HasPaginator("GetWidget") {
+		t.Error("GetWidget should not have a paginator")
+	}
+
+	enum, ok := s.Enum("WidgetStatus")
+	if !ok {
+		t.Fatal("WidgetStatus enum not found")
+	}
+	if got, want := len(enum.Values), 5; got != want {
+		t.Errorf("WidgetStatus values = %d, want %d", got, want)
+	}
+
+	widget, ok := s.Struct("Widget")
+	if !ok {
+		t.Fatal("Widget structure not found")
+	}
+
+	testCases := map[string]Kind{
+		"Arn":           KindString,
+		"Configuration": KindStruct,
+		"CreatedAt":     KindTimestamp,
+		"Metadata":      KindDocument,
+		"Status":        KindEnum,
+	}
+	for name, want := range testCases {
+		member, ok := widget.Member(name)
+		if !ok {
+			t.Errorf("Widget member %s not found", name)
+			continue
+		}
+		if got := member.Type.Kind; got != want {
+			t.Errorf("Widget member %s kind = %d, want %d", name, got, want)
+		}
+	}
+}
+
+func TestResource(t *testing.T) {
+	s := testService(t)
+
+	r, err := s.Resource("Widget", Operations{
+		Create: "CreateWidget",
+		Read:   "GetWidget",
+		Update: "UpdateWidget",
+		Delete: "DeleteWidget",
+		List:   "ListWidgets",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var got []string
+	for _, v := range r.Attributes {
+		got = append(got, v.TFName)
+	}
+	want := []string{"arn", "configuration", "created_at", "id", "size", "status", "tags", "tags_all", "widget_name"}
+	if !slices.Equal(got, want) {
+		t.Errorf("attributes = %v, want %v", got, want)
+	}
+
+	attributes := make(map[string]*Attribute)
+	for _, v := range r.Attributes {
+		attributes[v.TFName] = v
+	}
+
+	if v := attributes["widget_name"]; !v.Required || !strings.Contains(v.Schema, "RequiresReplace()") {
+		t.Errorf("widget_name should be required and force new: %s", v.Schema)
+	}
+	if v := attributes["size"]; !v.Optional || !v.Computed || strings.Contains(v.Schema, "RequiresReplace()") {
+		t.Errorf("size should be optional, computed and updatable: %s", v.Schema)
+	}
+	if v := attributes["configuration"]; !v.IsBlock || !strings.Contains(v.Schema, "listvalidator.SizeAtMost(1)") {
+		t.Errorf("configuration should be a block with at most one element: %s", v.Schema)
+	}
+	if got, want := attributes["id"].StructTag(), "`tfsdk:\"id\" autoflex:\"Id\"`"; got != want {
+		t.Errorf("id struct tag = %s, want %s", got, want)
+	}
+
+	if !r.HasARN || !r.HasTags || r.TagsIdentifierAttribute != "arn" {
+		t.Errorf("unexpected tagging: HasARN=%t HasTags=%t TagsIdentifierAttribute=%q", r.HasARN, r.HasTags, r.TagsIdentifierAttribute)
+	}
+	if got, want := r.ReadOutputType, "awstypes.Widget"; got != want {
+		t.Errorf("ReadOutputType = %s, want %s", got, want)
+	}
+	if got, want := r.ReadIdentifierMember, "WidgetIdentifier"; got != want {
+		t.Errorf("ReadIdentifierMember = %s, want %s", got, want)
+	}
+	if got, want := r.NotFoundException, "ResourceNotFoundException"; got != want {
+		t.Errorf("NotFoundException = %s, want %s", got, want)
+	}
+	if !slices.Equal(r.Skipped, []string{"Metadata"}) {
+		t.Errorf("Skipped = %v, want [Metadata]", r.Skipped)
+	}
+
+	if r.Status == nil {
+		t.Fatal("expected status")
+	}
+	if want := (&Status{
+		Member:        "Status",
+		Enum:          "WidgetStatus",
+		CreatePending: []string{"WidgetStatusCreating"},
+		CreateTarget:  []string{"WidgetStatusActive"},
+		UpdatePending: []string{"WidgetStatusCreating", "WidgetStatusUpdating"},
+		DeletePending: []string{"WidgetStatusActive", "WidgetStatusUpdating", "WidgetStatusDeleting"},
+	}); !reflect.DeepEqual(r.Status, want) {
+		t.Errorf("Status = %+v, want %+v", r.Status, want)
+	}
+
+	if got, want := r.ListOutputMember, "Widgets"; got != want {
+		t.Errorf("ListOutputMember = %s, want %s", got, want)
+	}
+	if got, want := r.ListSummaryMember, "Id"; got != want {
+		t.Errorf("ListSummaryMember = %s, want %s", got, want)
+	}
+	if !r.ListHasPaginator {
+		t.Error("ListHasPaginator should be true")
+	}
+
+	if _, err := s.Resource("Widget", Operations{Create: "CreateWidget"}); err == nil {
+		t.Error("expected error when read and delete operations are missing")
+	}
+}
@@ -0,0 +1,659 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimodel
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+)
+
+// Operations is the set of AWS API operations implementing a resource's lifecycle.
+// Create, Read and Delete are required.
+type Operations struct {
+	Create string // e.g. "CreateGraph".
+	Read   string // e.g. "GetGraph".
+	Update string // e.g. "UpdateGraph".
+	Delete string // e.g. "DeleteGraph".
+	List   string // e.g. "ListGraphs".
+}
+
+// Resource is a Plugin Framework resource derived from the shapes of its AWS API operations.
+type Resource struct {
+	Name         string // e.g. "Graph".
+	Operations   Operations
+	Attributes   []*Attribute // Top-level attributes and blocks, sorted by Terraform name.
+	NestedModels []*Model     // Models of nested blocks and attributes, sorted by name.
+	Skipped      []string     // AWS member names that could not be mapped.
+
+	HasARN                  bool
+	HasTags                 bool
+	TagsIdentifierAttribute string // e.g. "arn".
+	ReadIdentifierMember    string // Read input member identifying the resource, e.g. "GraphIdentifier".
+	ReadOutputMember        string // Read output member holding the resource, e.g. "Graph"; empty if the output itself.
+	ReadOutputType          string // e.g. "awstypes.Graph" or "neptunegraph.GetGraphOutput".
+	UpdateIdentifierMember  string
+	DeleteIdentifierMember  string
+	IDMember                string // AWS member mapped to the "id" attribute, e.g. "Id"; empty if none.
+	NotFoundException       string // e.g. "ResourceNotFoundException".
+
+	Status *Status
+
+	ListOutputMember  string // List output member holding the resource summaries, e.g. "Graphs".
+	ListSummaryMember string // Summary member holding the resource's "id", e.g. "Id".
+	ListHasPaginator  bool
+
+	Imports []Import
+}
+
+// Status describes how a resource's lifecycle status is read and which status values the waiters use.
+type Status struct {
+	Member        string   // e.g. "Status".
+	Enum          string   // e.g. "GraphStatus".
+	CreatePending []string // Go constant names, e.g. "GraphStatusCreating".
+	CreateTarget  []string
+	UpdatePending []string
+	DeletePending []string
+}
+
+// Attribute is a Plugin Framework attribute or block and its corresponding model field.
+type Attribute struct {
+	Name     string // Model field name, e.g. "ARN".
+	TFName   string // e.g. "arn".
+	AWSName  string // Set if the AWS member name differs from the model field name, e.g. "GraphArn".
+	Schema   string // Schema definition expression.
+	IsBlock  bool
+	Type     string // Model field type, e.g. "types.String".
+	Required bool
+	Optional bool
+	Computed bool
+}
+
+// StructTag returns the model field's struct tag.
+func (a *Attribute) StructTag() string {
+	if a.AWSName != "" {
+		return fmt.Sprintf("`tfsdk:%q autoflex:%q`", a.TFName, a.AWSName)
+	}
+	return fmt.Sprintf("`tfsdk:%q`", a.TFName)
+}
+
+// Model is the model of a nested block or attribute.
+type Model struct {
+	Name       string // e.g. "vpcConfigurationModel".
+	Attributes []*Attribute
+}
+
+// Import is a Go import.
+type Import struct {
+	Alias string
+	Path  string
+}
+
+// attribute flags used when mapping AWS members.
+type attributeFlags struct {
+	required, optional, computed, forceNew bool
+}
+
+type builder struct {
+	service  *Service
+	resource *Resource
+	models   map[string]*Model
+	imports  map[string]string // Import path to alias.
+	depth    int
+}
+
+const maxNestingDepth = 5
+
+// Resource derives a Plugin Framework resource named name from the specified operations.
+func (s *Service) Resource(name string, ops Operations) (*Resource, error) {
+	if ops.Create == "" || ops.Read == "" || ops.Delete == "" {
+		return nil, fmt.Errorf("create, read and delete operations are required")
+	}
+
+	createInput, _, err := s.Operation(ops.Create)
+	if err != nil {
+		return nil, err
+	}
+
+	readInput, readOutput, err := s.Operation(ops.Read)
+	if err != nil {
+		return nil, err
+	}
+
+	deleteInput, _, err := s.Operation(ops.Delete)
+	if err != nil {
+		return nil, err
+	}
+
+	var updateInput *Struct
+	if ops.Update != "" {
+		if updateInput, _, err = s.Operation(ops.Update); err != nil {
+			return nil, err
+		}
+	}
+
+	b := &builder{
+		service: s,
+		resource: &Resource{
+			Name:       name,
+			Operations: ops,
+		},
+		models:  make(map[string]*Model),
+		imports: make(map[string]string),
+	}
+	r := b.resource
+
+	// The Read output either holds the resource in a single structure member or is itself the resource.
+	read := readOutput
+	r.ReadOutputType = s.Package + "." + readOutput.Name
+	if len(readOutput.Members) == 1 && readOutput.Members[0].Type.Kind == KindStruct {
+		member := readOutput.Members[0]
+		if v, ok := s.Struct(member.Type.Name); ok {
+			read = v
+			r.ReadOutputMember = member.Name
+			r.ReadOutputType = "awstypes." + v.Name
+		}
+	}
+
+	r.ReadIdentifierMember = identifierMember(readInput)
+	r.DeleteIdentifierMember = identifierMember(deleteInput)
+	if updateInput != nil {
+		r.UpdateIdentifierMember = identifierMember(updateInput)
+	}
+
+	for _, v := range []string{"ResourceNotFoundException", "NotFoundException"} {
+		if _, ok := s.Struct(v); ok {
+			r.NotFoundException = v
+			break
+		}
+	}
+
+	var attributes []*Attribute
+
+	// Arguments from the Create input.
+	for _, member := range createInput.Members {
+		switch {
+		case isIdempotencyToken(member.Name):
+			continue
+		case member.Name == "Tags" && member.Type.Kind == KindMap:
+			r.HasTags = true
+			continue
+		}
+
+		flags := attributeFlags{
+			required: member.Required,
+			optional: !member.Required,
+		}
+		// Optional arguments that are read back may be defaulted by AWS.
+		if _, ok := read.Member(member.Name); ok && flags.optional {
+			flags.computed = true
+		}
+		if updateInput == nil {
+			flags.forceNew = true
+		} else if _, ok := updateInput.Member(member.Name); !ok {
+			flags.forceNew = true
+		}
+
+		if attribute := b.newAttribute(member, flags); attribute != nil {
+			attributes = append(attributes, attribute)
+		}
+	}
+
+	// Computed attributes from the Read output.
+	for _, member := range read.Members {
+		if _, ok := createInput.Member(member.Name); ok {
+			continue
+		}
+		if member.Name == "Tags" && member.Type.Kind == KindMap {
+			continue
+		}
+
+		switch tfName := names.ToSnakeCase(trimPrefix(member.Name, name)); {
+		case tfName == "arn" && member.Type.Kind == KindString:
+			attributes = append(attributes, &Attribute{
+				Name:    "ARN",
+				TFName:  names.AttrARN,
+				AWSName: awsNameIfDifferent(member.Name, "ARN"),
+				Schema:  "framework.ARNAttributeComputedOnly()",
+				Type:    "types.String",
+			})
+			r.HasARN = true
+			continue
+		case tfName == "id" && member.Type.Kind == KindString:
+			r.IDMember = member.Name
+			continue
+		}
+
+		if attribute := b.newAttribute(member, attributeFlags{computed: true}); attribute != nil {
+			attributes = append(attributes, attribute)
+		}
+
+		if r.Status == nil && member.Type.Kind == KindEnum && strings.HasSuffix(member.Name, "Status") {
+			r.Status = b.newStatus(member)
+		}
+	}
+
+	idAttribute := &Attribute{
+		Name:   "ID",
+		TFName: names.AttrID,
+		Schema: "framework.IDAttribute()",
+		Type:   "types.String",
+	}
+	if r.IDMember != "" {
+		idAttribute.AWSName = awsNameIfDifferent(r.IDMember, "ID")
+	} else {
+		idAttribute.AWSName = "-"
+	}
+	attributes = append(attributes, idAttribute)
+
+	if r.HasTags {
+		r.TagsIdentifierAttribute = names.AttrID
+		if r.HasARN {
+			r.TagsIdentifierAttribute = names.AttrARN
+		}
+		attributes = append(attributes, &Attribute{
+			Name:   "Tags",
+			TFName: names.AttrTags,
+			Schema: "tftags.TagsAttribute()",
+			Type:   "tftags.Map",
+		}, &Attribute{
+			Name:   "TagsAll",
+			TFName: names.AttrTagsAll,
+			Schema: "tftags.TagsAttributeComputedOnly()",
+			Type:   "tftags.Map",
+		})
+		b.imports["github.com/hashicorp/terraform-provider-aws/internal/tags"] = "tftags"
+	}
+
+	slices.SortFunc(attributes, func(a, b *Attribute) int {
+		return strings.Compare(a.TFName, b.TFName)
+	})
+	r.Attributes = attributes
+
+	for _, model := range b.models {
+		r.NestedModels = append(r.NestedModels, model)
+	}
+	slices.SortFunc(r.NestedModels, func(a, b *Model) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	if ops.List != "" {
+		if err := b.list(ops.List); err != nil {
+			return nil, err
+		}
+	}
+
+	for path, alias := range b.imports {
+		r.Imports = append(r.Imports, Import{Alias: alias, Path: path})
+	}
+	slices.SortFunc(r.Imports, func(a, b Import) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	return r, nil
+}
+
+// list populates the sweeper details from the List operation.
+func (b *builder) list(operation string) error {
+	r := b.resource
+
+	_, output, err := b.service.Operation(operation)
+	if err != nil {
+		return err
+	}
+
+	r.ListHasPaginator = b.service.HasPaginator(operation)
+
+	for _, member := range output.Members {
+		if member.Type.Kind != KindList || member.Type.Elem.Kind != KindStruct {
+			continue
+		}
+
+		r.ListOutputMember = member.Name
+
+		if summary, ok := b.service.Struct(member.Type.Elem.Name); ok {
+			for _, v := range []string{r.IDMember, "Id", r.Name + "Id", "Arn", r.Name + "Arn"} {
+				if _, ok := summary.Member(v); ok && v != "" {
+					r.ListSummaryMember = v
+					break
+				}
+			}
+		}
+
+		break
+	}
+
+	return nil
+}
+
+// newAttribute maps an AWS member to a Plugin Framework attribute or block.
+// nil is returned if the member's type cannot be mapped.
+func (b *builder) newAttribute(member *Member, flags attributeFlags) *Attribute {
+	fieldName := fieldName(member.Name)
+	attribute := &Attribute{
+		Name:     fieldName,
+		TFName:   names.ToSnakeCase(fieldName),
+		AWSName:  awsNameIfDifferent(member.Name, fieldName),
+		Required: flags.required,
+		Optional: flags.optional,
+		Computed: flags.computed,
+	}
+
+	var (
+		schemaType   string
+		properties   []string
+		planModifier string // e.g. "string".
+	)
+
+	switch typ := member.Type; typ.Kind {
+	case KindString:
+		schemaType, attribute.Type, planModifier = "schema.StringAttribute", "types.String", "string"
+	case KindEnum:
+		schemaType, attribute.Type, planModifier = "schema.StringAttribute", fmt.Sprintf("fwtypes.StringEnum[awstypes.%s]", typ.Name), "string"
+		properties = append(properties, fmt.Sprintf("CustomType: fwtypes.StringEnumType[awstypes.%s](),", typ.Name))
+		b.useFWTypes()
+	case KindBool:
+		schemaType, attribute.Type, planModifier = "schema.BoolAttribute", "types.Bool", "bool"
+	case KindInt32, KindInt64:
+		schemaType, attribute.Type, planModifier = "schema.Int64Attribute", "types.Int64", "int64"
+	case KindFloat32, KindFloat64:
+		schemaType, attribute.Type, planModifier = "schema.Float64Attribute", "types.Float64", "float64"
+	case KindTimestamp:
+		schemaType, attribute.Type, planModifier = "schema.StringAttribute", "timetypes.RFC3339", "string"
+		properties = append(properties, "CustomType: timetypes.RFC3339Type{},")
+		b.imports["github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"] = ""
+	case KindList:
+		switch typ.Elem.Kind {
+		case KindString, KindEnum:
+			schemaType, attribute.Type, planModifier = "schema.ListAttribute", "fwtypes.ListValueOf[types.String]", "list"
+			properties = append(properties, "CustomType: fwtypes.ListOfStringType,", "ElementType: types.StringType,")
+			b.useFWTypes()
+		case KindStruct:
+			model := b.model(typ.Elem.Name)
+			if model == "" {
+				b.skip(member)
+				return nil
+			}
+			return b.nestedObject(attribute, model, false, flags)
+		default:
+			b.skip(member)
+			return nil
+		}
+	case KindMap:
+		switch typ.Elem.Kind {
+		case KindString:
+			schemaType, attribute.Type, planModifier = "schema.MapAttribute", "fwtypes.MapValueOf[types.String]", "map"
+			properties = append(properties, "CustomType: fwtypes.MapOfStringType,", "ElementType: types.StringType,")
+			b.useFWTypes()
+		default:
+			b.skip(member)
+			return nil
+		}
+	case KindStruct:
+		model := b.model(typ.Name)
+		if model == "" {
+			b.skip(member)
+			return nil
+		}
+		return b.nestedObject(attribute, model, true, flags)
+	default:
+		b.skip(member)
+		return nil
+	}
+
+	properties = append(properties, flagProperties(flags)...)
+	properties = append(properties, b.planModifiers(planModifier, flags)...)
+	attribute.Schema = fmt.Sprintf("%s{\n%s\n}", schemaType, strings.Join(properties, "\n"))
+
+	return attribute
+}
+
+// nestedObject maps an AWS structure member to a list nested block, or to a list attribute if computed.
+func (b *builder) nestedObject(attribute *Attribute, model string, maxItemsOne bool, flags attributeFlags) *Attribute {
+	attribute.Type = fmt.Sprintf("fwtypes.ListNestedObjectValueOf[%s]", model)
+	b.useFWTypes()
+
+	if flags.computed && !flags.optional {
+		properties := []string{
+			fmt.Sprintf("CustomType: fwtypes.NewListNestedObjectTypeOf[%s](ctx),", model),
+			fmt.Sprintf("ElementType: fwtypes.NewObjectTypeOf[%s](ctx),", model),
+		}
+		properties = append(properties, flagProperties(flags)...)
+		properties = append(properties, b.planModifiers("list", flags)...)
+		attribute.Schema = fmt.Sprintf("schema.ListAttribute{\n%s\n}", strings.Join(properties, "\n"))
+
+		return attribute
+	}
+
+	attribute.IsBlock = true
+	attribute.Required, attribute.Optional = false, false
+
+	properties := []string{
+		fmt.Sprintf("CustomType: fwtypes.NewListNestedObjectTypeOf[%s](ctx),", model),
+	}
+
+	var validators []string
+	if maxItemsOne {
+		validators = append(validators, "listvalidator.SizeAtMost(1),")
+	}
+	if flags.required {
+		validators = append(validators, "listvalidator.IsRequired(),")
+	}
+	if len(validators) > 0 {
+		properties = append(properties, fmt.Sprintf("Validators: []validator.List{\n%s\n},", strings.Join(validators, "\n")))
+		b.imports["github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"] = ""
+		b.imports["github.com/hashicorp/terraform-plugin-framework/schema/validator"] = ""
+	}
+	if flags.forceNew {
+		properties = append(properties, b.planModifiers("list", attributeFlags{forceNew: true})...)
+	}
+
+	var nested []string
+	for _, v := range b.models[model].Attributes {
+		if v.IsBlock {
+			continue
+		}
+		nested = append(nested, fmt.Sprintf("%q: %s,", v.TFName, v.Schema))
+	}
+	if len(nested) > 0 {
+		properties = append(properties, fmt.Sprintf("NestedObject: schema.NestedBlockObject{\nAttributes: map[string]schema.Attribute{\n%s\n},", strings.Join(nested, "\n")))
+	} else {
+		properties = append(properties, "NestedObject: schema.NestedBlockObject{")
+	}
+
+	var blocks []string
+	for _, v := range b.models[model].Attributes {
+		if !v.IsBlock {
+			continue
+		}
+		blocks = append(blocks, fmt.Sprintf("%q: %s,", v.TFName, v.Schema))
+	}
+	if len(blocks) > 0 {
+		properties = append(properties, fmt.Sprintf("Blocks: map[string]schema.Block{\n%s\n},", strings.Join(blocks, "\n")))
+	}
+	properties = append(properties, "},")
+
+	attribute.Schema = fmt.Sprintf("schema.ListNestedBlock{\n%s\n}", strings.Join(properties, "\n"))
+
+	return attribute
+}
+
+// model returns the name of the model for the specified types package structure, creating it if necessary.
+func (b *builder) model(structName string) string {
+	name := convert.ToLowercasePrefix(structName) + "Model"
+
+	if _, ok := b.models[name]; ok {
+		return name
+	}
+
+	v, ok := b.service.Struct(structName)
+	if !ok || b.depth >= maxNestingDepth {
+		return ""
+	}
+
+	model := &Model{
+		Name: name,
+	}
+	// Register before recursing to terminate on recursive structures.
+	b.models[name] = model
+
+	b.depth++
+	for _, member := range v.Members {
+		flags := attributeFlags{
+			required: member.Required,
+			optional: !member.Required,
+		}
+		if attribute := b.newAttribute(member, flags); attribute != nil {
+			model.Attributes = append(model.Attributes, attribute)
+		}
+	}
+	b.depth--
+
+	slices.SortFunc(model.Attributes, func(a, b *Attribute) int {
+		return strings.Compare(a.TFName, b.TFName)
+	})
+
+	return name
+}
+
+func (b *builder) newStatus(member *Member) *Status {
+	enum, ok := b.service.Enum(member.Type.Name)
+	if !ok {
+		return nil
+	}
+
+	status := &Status{
+		Member: member.Name,
+		Enum:   enum.Name,
+	}
+
+	for _, v := range enum.Values {
+		value := strings.ToUpper(strings.NewReplacer("-", "_", " ", "_").Replace(v.Value))
+
+		switch {
+		case slices.Contains(createPendingStatuses, value):
+			status.CreatePending = append(status.CreatePending, v.Name)
+			status.UpdatePending = append(status.UpdatePending, v.Name)
+		case slices.Contains(targetStatuses, value):
+			status.CreateTarget = append(status.CreateTarget, v.Name)
+			status.DeletePending = append(status.DeletePending, v.Name)
+		case slices.Contains(updatePendingStatuses, value):
+			status.UpdatePending = append(status.UpdatePending, v.Name)
+			status.DeletePending = append(status.DeletePending, v.Name)
+		case slices.Contains(deletePendingStatuses, value):
+			status.DeletePending = append(status.DeletePending, v.Name)
+		}
+	}
+
+	if len(status.CreateTarget) == 0 {
+		return nil
+	}
+
+	return status
+}
+
+var (
+	createPendingStatuses = []string{"CREATING", "PENDING", "PROVISIONING", "IMPORTING", "INITIALIZING", "STARTING", "IN_PROGRESS", "CREATE_IN_PROGRESS"}
+	targetStatuses        = []string{"ACTIVE", "AVAILABLE", "READY", "CREATED", "RUNNING", "SUCCEEDED", "ENABLED", "COMPLETED", "CREATE_COMPLETE", "UPDATE_COMPLETE"}
+	updatePendingStatuses = []string{"UPDATING", "MODIFYING", "UPDATE_IN_PROGRESS"}
+	deletePendingStatuses = []string{"DELETING", "DELETE_IN_PROGRESS"}
+)
+
+func (b *builder) planModifiers(kind string, flags attributeFlags) []string {
+	pkg := kind + "planmodifier"
+
+	var modifiers []string
+	if flags.forceNew {
+		modifiers = append(modifiers, pkg+".RequiresReplace(),")
+	}
+	if flags.computed {
+		modifiers = append(modifiers, pkg+".UseStateForUnknown(),")
+	}
+	if len(modifiers) == 0 {
+		return nil
+	}
+
+	b.imports["github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"] = ""
+	b.imports["github.com/hashicorp/terraform-plugin-framework/resource/schema/"+pkg] = ""
+
+	return []string{fmt.Sprintf("PlanModifiers: []planmodifier.%s{\n%s\n},", planModifierType(kind), strings.Join(modifiers, "\n"))}
+}
+
+func (b *builder) skip(member *Member) {
+	b.resource.Skipped = append(b.resource.Skipped, member.Name)
+}
+
+func (b *builder) useFWTypes() {
+	b.imports["github.com/hashicorp/terraform-provider-aws/internal/framework/types"] = "fwtypes"
+}
+
+func flagProperties(flags attributeFlags) []string {
+	var properties []string
+
+	if flags.required {
+		properties = append(properties, "Required: true,")
+	}
+	if flags.optional {
+		properties = append(properties, "Optional: true,")
+	}
+	if flags.computed {
+		properties = append(properties, "Computed: true,")
+	}
+
+	return properties
+}
+
+func planModifierType(kind string) string {
+	switch kind {
+	case "int64":
+		return "Int64"
+	case "float64":
+		return "Float64"
+	default:
+		return strings.ToUpper(kind[:1]) + kind[1:]
+	}
+}
+
+// identifierMember returns the name of the first required member of an operation input.
+func identifierMember(input *Struct) string {
+	for _, member := range input.Members {
+		if member.Required && member.Type.Kind == KindString {
+			return member.Name
+		}
+	}
+	return ""
+}
+
+// fieldName returns the model field name for an AWS member name.
+func fieldName(awsName string) string {
+	switch {
+	case strings.HasSuffix(awsName, "Arn"):
+		return strings.TrimSuffix(awsName, "Arn") + "ARN"
+	case strings.HasSuffix(awsName, "Id"):
+		return strings.TrimSuffix(awsName, "Id") + "ID"
+	}
+	return awsName
+}
+
+// trimPrefix removes the resource name prefix from an AWS member name, e.g. "GraphArn" becomes "Arn".
+func trimPrefix(awsName, resourceName string) string {
+	if v := strings.TrimPrefix(awsName, resourceName); v != "" {
+		return v
+	}
+	return awsName
+}
+
+func awsNameIfDifferent(awsName, fieldName string) string {
+	if awsName == fieldName {
+		return ""
+	}
+	return awsName
+}
+
+func isIdempotencyToken(name string) bool {
+	return name == "ClientToken" || name == "ClientRequestToken" || name == "IdempotencyToken"
+}
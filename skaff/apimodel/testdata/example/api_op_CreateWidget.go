@@ -0,0 +1,43 @@
+package example
+
+import (
+	"context"
+
+	"example/types"
+)
+
+func (c *Client) CreateWidget(ctx context.Context, params *CreateWidgetInput, optFns ...func(*Options)) (*CreateWidgetOutput, error) {
+	return nil, nil
+}
+
+type CreateWidgetInput struct {
+
+	// The widget's name.
+	//
+	// This member is required.
+	WidgetName *string
+
+	// A unique, case-sensitive identifier.
+	ClientToken *string
+
+	// The widget's configuration.
+	Configuration *types.Configuration
+
+	// The widget's size.
+	Size types.Size
+
+	// Tags.
+	Tags map[string]string
+
+	noSmithyDocumentSerde
+}
+
+type CreateWidgetOutput struct {
+
+	// The widget's identifier.
+	//
+	// This member is required.
+	Id *string
+
+	noSmithyDocumentSerde
+}
@@ -0,0 +1,23 @@
+package example
+
+import (
+	"context"
+)
+
+func (c *Client) DeleteWidget(ctx context.Context, params *DeleteWidgetInput, optFns ...func(*Options)) (*DeleteWidgetOutput, error) {
+	return nil, nil
+}
+
+type DeleteWidgetInput struct {
+
+	// The widget's identifier.
+	//
+	// This member is required.
+	WidgetIdentifier *string
+
+	noSmithyDocumentSerde
+}
+
+type DeleteWidgetOutput struct {
+	noSmithyDocumentSerde
+}
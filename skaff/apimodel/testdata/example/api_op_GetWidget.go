@@ -0,0 +1,29 @@
+package example
+
+import (
+	"context"
+
+	"example/types"
+)
+
+func (c *Client) GetWidget(ctx context.Context, params *GetWidgetInput, optFns ...func(*Options)) (*GetWidgetOutput, error) {
+	return nil, nil
+}
+
+type GetWidgetInput struct {
+
+	// The widget's identifier.
+	//
+	// This member is required.
+	WidgetIdentifier *string
+
+	noSmithyDocumentSerde
+}
+
+type GetWidgetOutput struct {
+
+	// The widget.
+	Widget *types.Widget
+
+	noSmithyDocumentSerde
+}
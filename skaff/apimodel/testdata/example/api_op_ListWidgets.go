@@ -0,0 +1,35 @@
+package example
+
+import (
+	"context"
+
+	"example/types"
+)
+
+func (c *Client) ListWidgets(ctx context.Context, params *ListWidgetsInput, optFns ...func(*Options)) (*ListWidgetsOutput, error) {
+	return nil, nil
+}
+
+type ListWidgetsInput struct {
+	NextToken *string
+
+	noSmithyDocumentSerde
+}
+
+type ListWidgetsOutput struct {
+
+	// The widgets.
+	//
+	// This member is required.
+	Widgets []types.WidgetSummary
+
+	NextToken *string
+
+	noSmithyDocumentSerde
+}
+
+type ListWidgetsPaginator struct{}
+
+func NewListWidgetsPaginator(client *Client, params *ListWidgetsInput) *ListWidgetsPaginator {
+	return nil
+}
@@ -0,0 +1,28 @@
+package example
+
+import (
+	"context"
+
+	"example/types"
+)
+
+func (c *Client) UpdateWidget(ctx context.Context, params *UpdateWidgetInput, optFns ...func(*Options)) (*UpdateWidgetOutput, error) {
+	return nil, nil
+}
+
+type UpdateWidgetInput struct {
+
+	// The widget's identifier.
+	//
+	// This member is required.
+	WidgetIdentifier *string
+
+	// The widget's size.
+	Size types.Size
+
+	noSmithyDocumentSerde
+}
+
+type UpdateWidgetOutput struct {
+	noSmithyDocumentSerde
+}
@@ -0,0 +1,20 @@
+package types
+
+type Size string
+
+// Enum values for Size
+const (
+	SizeSmall Size = "SMALL"
+	SizeLarge Size = "LARGE"
+)
+
+type WidgetStatus string
+
+// Enum values for WidgetStatus
+const (
+	WidgetStatusCreating WidgetStatus = "CREATING"
+	WidgetStatusActive   WidgetStatus = "ACTIVE"
+	WidgetStatusUpdating WidgetStatus = "UPDATING"
+	WidgetStatusDeleting WidgetStatus = "DELETING"
+	WidgetStatusFailed   WidgetStatus = "FAILED"
+)
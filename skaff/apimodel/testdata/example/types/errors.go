@@ -0,0 +1,5 @@
+package types
+
+type ResourceNotFoundException struct {
+	Message *string
+}
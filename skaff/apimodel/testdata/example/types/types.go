@@ -0,0 +1,65 @@
+package types
+
+import (
+	"time"
+
+	"example/document"
+)
+
+// The widget's configuration.
+type Configuration struct {
+
+	// The configuration's subnets.
+	//
+	// This member is required.
+	SubnetIds []string
+
+	noSmithyDocumentSerde
+}
+
+// A widget.
+type Widget struct {
+
+	// The widget's ARN.
+	//
+	// This member is required.
+	Arn *string
+
+	// The widget's identifier.
+	//
+	// This member is required.
+	Id *string
+
+	// The widget's name.
+	//
+	// This member is required.
+	WidgetName *string
+
+	Configuration *Configuration
+
+	CreatedAt *time.Time
+
+	Metadata document.Interface
+
+	Size Size
+
+	// The widget's status.
+	//
+	// This member is required.
+	Status WidgetStatus
+
+	noSmithyDocumentSerde
+}
+
+// A widget summary.
+type WidgetSummary struct {
+
+	// The widget's identifier.
+	//
+	// This member is required.
+	Id *string
+
+	noSmithyDocumentSerde
+}
+
+type noSmithyDocumentSerde struct{}
@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/skaff/listresource"
+	"github.com/spf13/cobra"
+)
+
+var listResourceCmd = &cobra.Command{
+	Use:   "listresource",
+	Short: "Create scaffolding for a list resource",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return listresource.Create(name, snakeName, !clearComments, force, includeTags)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(listResourceCmd)
+	listResourceCmd.Flags().StringVarP(&snakeName, "snakename", "s", "", "if skaff doesn't get it right, explicitly give name in snake case (e.g., db_vpc_instance)")
+	listResourceCmd.Flags().BoolVarP(&clearComments, "clear-comments", "c", false, "do not include instructional comments in source")
+	listResourceCmd.Flags().StringVarP(&name, "name", "n", "", "name of the resource whose instances are listed")
+	listResourceCmd.Flags().BoolVarP(&force, "force", "f", false, "force creation, overwriting existing files")
+	listResourceCmd.Flags().BoolVarP(&includeTags, "include-tags", "t", false, "Indicate that this resource has tags and the code for listing tags should be generated")
+}
@@ -4,6 +4,7 @@
 package cmd
 
 import (
+	"github.com/hashicorp/terraform-provider-aws/skaff/apimodel"
 	"github.com/hashicorp/terraform-provider-aws/skaff/resource"
 	"github.com/spf13/cobra"
 )
@@ -15,13 +16,14 @@ var (
 	force         bool
 	pluginSDKV2   bool
 	includeTags   bool
+	operations    apimodel.Operations
 )
 
 var resourceCmd = &cobra.Command{
 	Use:   "resource",
 	Short: "Create scaffolding for a resource",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return resource.Create(name, snakeName, !clearComments, force, !pluginSDKV2, includeTags)
+		return resource.Create(name, snakeName, !clearComments, force, !pluginSDKV2, includeTags, operations)
 	},
 }
 
@@ -33,4 +35,10 @@ func init() {
 	resourceCmd.Flags().BoolVarP(&force, "force", "f", false, "force creation, overwriting existing files")
 	resourceCmd.Flags().BoolVarP(&pluginSDKV2, "plugin-sdkv2", "p", false, "generate for Terraform Plugin SDK V2")
 	resourceCmd.Flags().BoolVarP(&includeTags, "include-tags", "t", false, "Indicate that this resource has tags and the code for tagging should be generated")
+	resourceCmd.Flags().StringVar(&operations.Create, "create", "", "name of the AWS API operation that creates the resource (e.g., CreateGraph); generates from the AWS SDK for Go v2 API model")
+	resourceCmd.Flags().StringVar(&operations.Read, "read", "", "name of the AWS API operation that reads the resource (e.g., GetGraph)")
+	resourceCmd.Flags().StringVar(&operations.Update, "update", "", "name of the AWS API operation that updates the resource (e.g., UpdateGraph)")
+	resourceCmd.Flags().StringVar(&operations.Delete, "delete", "", "name of the AWS API operation that deletes the resource (e.g., DeleteGraph)")
+	resourceCmd.Flags().StringVar(&operations.List, "list", "", "name of the AWS API operation that lists the resources (e.g., ListGraphs); used to generate a sweeper")
+	resourceCmd.MarkFlagsRequiredTogether("create", "read", "delete")
 }
@@ -4,19 +4,16 @@
 package datasource
 
 import (
-	"bytes"
 	_ "embed"
-	"errors"
 	"fmt"
-	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
-	"text/template"
 
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/names/data"
 	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+	"github.com/hashicorp/terraform-provider-aws/skaff/internal/generate"
 )
 
 //go:embed datasource.gtpl
@@ -99,59 +96,20 @@ func Create(dsName, snakeName string, comments, force, pluginFramework, tags boo
 		tmpl = datasourceFrameworkTmpl
 	}
 	f := fmt.Sprintf("%s_data_source.go", snakeName)
-	if err = writeTemplate("newds", f, tmpl, force, templateData); err != nil {
+	if err = generate.WriteTemplate("newds", f, tmpl, force, templateData, false); err != nil {
 		return fmt.Errorf("writing datasource template: %w", err)
 	}
 
 	tf := fmt.Sprintf("%s_data_source_test.go", snakeName)
-	if err = writeTemplate("dstest", tf, datasourceTestTmpl, force, templateData); err != nil {
+	if err = generate.WriteTemplate("dstest", tf, datasourceTestTmpl, force, templateData, false); err != nil {
 		return fmt.Errorf("writing datasource test template: %w", err)
 	}
 
 	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, snakeName)
 	wf = filepath.Join("..", "..", "..", "website", "docs", "d", wf)
-	if err = writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
+	if err = generate.WriteTemplate("webdoc", wf, websiteTmpl, force, templateData, false); err != nil {
 		return fmt.Errorf("writing datasource website doc template: %w", err)
 	}
 
 	return nil
 }
-
-func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
-	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
-		return fmt.Errorf("file (%s) already exists and force is not set", filename)
-	}
-
-	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
-	if err != nil {
-		return fmt.Errorf("error opening file (%s): %s", filename, err)
-	}
-
-	tplate, err := template.New(templateName).Parse(tmpl)
-	if err != nil {
-		return fmt.Errorf("error parsing template: %s", err)
-	}
-
-	var buffer bytes.Buffer
-	err = tplate.Execute(&buffer, td)
-	if err != nil {
-		return fmt.Errorf("error executing template: %s", err)
-	}
-
-	//contents, err := format.Source(buffer.Bytes())
-	//if err != nil {
-	//	return fmt.Errorf("error formatting generated file: %s", err)
-	//}
-
-	//if _, err := f.Write(contents); err != nil {
-	if _, err := f.Write(buffer.Bytes()); err != nil {
-		f.Close() // ignore error; Write error takes precedence
-		return fmt.Errorf("error writing to file (%s): %s", filename, err)
-	}
-
-	if err := f.Close(); err != nil {
-		return fmt.Errorf("error closing file (%s): %s", filename, err)
-	}
-
-	return nil
-}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ephemeral
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+	"github.com/hashicorp/terraform-provider-aws/skaff/internal/generate"
+)
+
+//go:embed ephemeral.gtpl
+var ephemeralTmpl string
+
+//go:embed ephemeraltest.gtpl
+var ephemeralTestTmpl string
+
+//go:embed websitedoc.gtpl
+var websiteTmpl string
+
+type TemplateData struct {
+	EphemeralResource      string
+	EphemeralResourceLower string
+	EphemeralResourceSnake string
+	HumanFriendlyService   string
+	IncludeComments        bool
+	SDKPackage             string
+	ServicePackage         string
+	Service                string
+	ServiceLower           string
+	AWSServiceName         string
+	HumanEphemeralName     string
+	ProviderResourceName   string
+}
+
+func Create(name, snakeName string, comments, force bool) error {
+	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
+	if err != nil {
+		return fmt.Errorf("error reading working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+
+	if name == "" {
+		return fmt.Errorf("error checking: no name given")
+	}
+
+	if name == strings.ToLower(name) {
+		return fmt.Errorf("error checking: name should be properly capitalized (e.g., SecretValue)")
+	}
+
+	if snakeName != "" && snakeName != strings.ToLower(snakeName) {
+		return fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., secret_value)")
+	}
+
+	if snakeName == "" {
+		snakeName = names.ToSnakeCase(name)
+	}
+
+	service, err := data.LookupService(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error looking up service package data for %q: %w", servicePackage, err)
+	}
+
+	templateData := TemplateData{
+		EphemeralResource:      name,
+		EphemeralResourceLower: strings.ToLower(name),
+		EphemeralResourceSnake: snakeName,
+		HumanFriendlyService:   service.HumanFriendly(),
+		IncludeComments:        comments,
+		SDKPackage:             service.GoV2Package(),
+		ServicePackage:         servicePackage,
+		Service:                service.ProviderNameUpper(),
+		ServiceLower:           strings.ToLower(service.ProviderNameUpper()),
+		AWSServiceName:         service.FullHumanFriendly(),
+		HumanEphemeralName:     convert.ToHumanResName(name),
+		ProviderResourceName:   convert.ToProviderResourceName(servicePackage, snakeName),
+	}
+
+	f := fmt.Sprintf("%s_ephemeral.go", snakeName)
+	if err = generate.WriteTemplate("newephemeral", f, ephemeralTmpl, force, templateData, false); err != nil {
+		return fmt.Errorf("writing ephemeral resource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_ephemeral_test.go", snakeName)
+	if err = generate.WriteTemplate("ephemeraltest", tf, ephemeralTestTmpl, force, templateData, false); err != nil {
+		return fmt.Errorf("writing ephemeral resource test template: %w", err)
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, snakeName)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "ephemeral-resources", wf)
+	if err = generate.WriteTemplate("webdoc", wf, websiteTmpl, force, templateData, false); err != nil {
+		return fmt.Errorf("writing ephemeral resource website doc template: %w", err)
+	}
+
+	return nil
+}
@@ -4,18 +4,14 @@
 package function
 
 import (
-	"bytes"
 	_ "embed"
-	"errors"
 	"fmt"
-	"io/fs"
-	"os"
 	"path/filepath"
 	"strings"
-	"text/template"
 
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+	"github.com/hashicorp/terraform-provider-aws/skaff/internal/generate"
 )
 
 //go:embed function.gtpl
@@ -58,53 +54,20 @@ func Create(name, snakeName, description string, comments, force bool) error {
 
 	tmpl := functionTmpl
 	f := fmt.Sprintf("%s.go", snakeName)
-	if err := writeTemplate("function", f, tmpl, force, templateData); err != nil {
+	if err := generate.WriteTemplate("function", f, tmpl, force, templateData, false); err != nil {
 		return fmt.Errorf("writing resource template: %w", err)
 	}
 
 	tf := fmt.Sprintf("%s_test.go", snakeName)
-	if err := writeTemplate("functiontest", tf, functionTestTmpl, force, templateData); err != nil {
+	if err := generate.WriteTemplate("functiontest", tf, functionTestTmpl, force, templateData, false); err != nil {
 		return fmt.Errorf("writing resource test template: %w", err)
 	}
 
 	wf := fmt.Sprintf("%s.html.markdown", snakeName)
 	wf = filepath.Join("..", "..", "website", "docs", "functions", wf)
-	if err := writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
+	if err := generate.WriteTemplate("webdoc", wf, websiteTmpl, force, templateData, false); err != nil {
 		return fmt.Errorf("writing resource website doc template: %w", err)
 	}
 
 	return nil
 }
-
-func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
-	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
-		return fmt.Errorf("file (%s) already exists and force is not set", filename)
-	}
-
-	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
-	if err != nil {
-		return fmt.Errorf("error opening file (%s): %s", filename, err)
-	}
-
-	tplate, err := template.New(templateName).Parse(tmpl)
-	if err != nil {
-		return fmt.Errorf("error parsing template: %s", err)
-	}
-
-	var buffer bytes.Buffer
-	err = tplate.Execute(&buffer, td)
-	if err != nil {
-		return fmt.Errorf("error executing template: %s", err)
-	}
-
-	if _, err := f.Write(buffer.Bytes()); err != nil {
-		f.Close() // ignore error; Write error takes precedence
-		return fmt.Errorf("error writing to file (%s): %s", filename, err)
-	}
-
-	if err := f.Close(); err != nil {
-		return fmt.Errorf("error closing file (%s): %s", filename, err)
-	}
-
-	return nil
-}
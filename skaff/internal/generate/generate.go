@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generate
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"os"
+	"text/template"
+)
+
+// WriteTemplate executes the template with the specified data and writes the result to filename.
+// If formatSource is set, the result is formatted as Go source code.
+// The file is only opened, and so truncated, once its contents are known to be complete,
+// so that a template or formatting error leaves any existing file unchanged.
+func WriteTemplate(templateName, filename, tmpl string, force bool, data any, formatSource bool) error {
+	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+	}
+
+	tplate, err := template.New(templateName).Parse(tmpl)
+	if err != nil {
+		return fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tplate.Execute(&buffer, data)
+	if err != nil {
+		return fmt.Errorf("error executing template: %s", err)
+	}
+
+	contents := buffer.Bytes()
+
+	if formatSource {
+		contents, err = format.Source(contents)
+		if err != nil {
+			return fmt.Errorf("error formatting generated file (%s): %s", filename, err)
+		}
+	}
+
+	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("error opening file (%s): %s", filename, err)
+	}
+
+	if _, err := f.Write(contents); err != nil {
+		f.Close() // ignore error; Write error takes precedence
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("error closing file (%s): %s", filename, err)
+	}
+
+	return nil
+}
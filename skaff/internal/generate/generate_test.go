@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteTemplate(t *testing.T) {
+	const existing = "existing contents\n"
+
+	testCases := []struct {
+		TestName     string
+		Template     string
+		FormatSource bool
+		Expected     string
+		ExpectError  bool
+	}{
+		{
+			TestName: "success",
+			Template: "package {{ .Name }}\n",
+			Expected: "package test\n",
+		},
+		{
+			TestName:     "formatted",
+			Template:     "package   {{ .Name }}\nvar  x = 1\n",
+			FormatSource: true,
+			Expected:     "package test\n\nvar x = 1\n",
+		},
+		{
+			TestName:    "parse error",
+			Template:    "package {{ .Name }\n",
+			Expected:    existing,
+			ExpectError: true,
+		},
+		{
+			TestName:    "execute error",
+			Template:    "package {{ .Missing }}\n",
+			Expected:    existing,
+			ExpectError: true,
+		},
+		{
+			TestName:     "format error",
+			Template:     "package {{ .Name }}\nfunc {\n",
+			FormatSource: true,
+			Expected:     existing,
+			ExpectError:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			filename := filepath.Join(t.TempDir(), "test.go")
+			if err := os.WriteFile(filename, []byte(existing), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			data := struct{ Name string }{Name: "test"}
+			err := WriteTemplate("test", filename, testCase.Template, true, data, testCase.FormatSource)
+
+			if got, want := err != nil, testCase.ExpectError; got != want {
+				t.Errorf("got error %t (%v), expected %t", got, err, want)
+			}
+
+			got, err := os.ReadFile(filename)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(got) != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
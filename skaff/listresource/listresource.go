@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package listresource
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+	"github.com/hashicorp/terraform-provider-aws/skaff/internal/generate"
+)
+
+//go:embed listresource.gtpl
+var listResourceTmpl string
+
+//go:embed listresourcetest.gtpl
+var listResourceTestTmpl string
+
+// resourceListWebsiteDoc is the aws_resource_list data source's documentation, which lists the supported resource types.
+var resourceListWebsiteDoc = filepath.Join("..", "..", "..", "website", "docs", "d", "resource_list.html.markdown")
+
+type TemplateData struct {
+	Resource             string
+	ResourceLower        string
+	ResourceSnake        string
+	HumanFriendlyService string
+	IncludeComments      bool
+	IncludeTags          bool
+	SDKPackage           string
+	ServicePackage       string
+	Service              string
+	ServiceLower         string
+	AWSServiceName       string
+	HumanResourceName    string
+	ProviderResourceName string
+}
+
+func Create(resName, snakeName string, comments, force, tags bool) error {
+	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
+	if err != nil {
+		return fmt.Errorf("error reading working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+
+	if resName == "" {
+		return fmt.Errorf("error checking: no name given")
+	}
+
+	if resName == strings.ToLower(resName) {
+		return fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
+	}
+
+	if snakeName != "" && snakeName != strings.ToLower(snakeName) {
+		return fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
+	}
+
+	if snakeName == "" {
+		snakeName = names.ToSnakeCase(resName)
+	}
+
+	service, err := data.LookupService(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error looking up service package data for %q: %w", servicePackage, err)
+	}
+
+	templateData := TemplateData{
+		Resource:             resName,
+		ResourceLower:        strings.ToLower(resName),
+		ResourceSnake:        snakeName,
+		HumanFriendlyService: service.HumanFriendly(),
+		IncludeComments:      comments,
+		IncludeTags:          tags,
+		SDKPackage:           service.GoV2Package(),
+		ServicePackage:       servicePackage,
+		Service:              service.ProviderNameUpper(),
+		ServiceLower:         strings.ToLower(service.ProviderNameUpper()),
+		AWSServiceName:       service.FullHumanFriendly(),
+		HumanResourceName:    convert.ToHumanResName(resName),
+		ProviderResourceName: convert.ToProviderResourceName(servicePackage, snakeName),
+	}
+
+	f := fmt.Sprintf("%s_list.go", snakeName)
+	if err = generate.WriteTemplate("newlist", f, listResourceTmpl, force, templateData, false); err != nil {
+		return fmt.Errorf("writing list resource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_list_test.go", snakeName)
+	if err = generate.WriteTemplate("listtest", tf, listResourceTestTmpl, force, templateData, false); err != nil {
+		return fmt.Errorf("writing list resource test template: %w", err)
+	}
+
+	if err = addToWebsiteDoc(resourceListWebsiteDoc, templateData.ProviderResourceName); err != nil {
+		return fmt.Errorf("updating list resource website doc: %w", err)
+	}
+
+	return nil
+}
+
+// addToWebsiteDoc adds the resource type to the sorted list of supported resource types in the aws_resource_list data source's documentation.
+func addToWebsiteDoc(filename, typeName string) error {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("error reading file (%s): %s", filename, err)
+	}
+
+	lines := strings.Split(string(b), "\n")
+
+	// The supported resource types are the first bulleted list in the file.
+	start := slices.IndexFunc(lines, isResourceTypeItem)
+	if start == -1 {
+		return fmt.Errorf("no list of resource types found in %s", filename)
+	}
+	end := start
+	for end < len(lines) && isResourceTypeItem(lines[end]) {
+		end++
+	}
+
+	item := fmt.Sprintf("* `%s`", typeName)
+	i, found := slices.BinarySearch(lines[start:end], item)
+	if found {
+		return nil
+	}
+	lines = slices.Insert(lines, start+i, item)
+
+	if err := os.WriteFile(filename, []byte(strings.Join(lines, "\n")), 0644); err != nil {
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	return nil
+}
+
+func isResourceTypeItem(line string) bool {
+	return strings.HasPrefix(line, "* `aws_")
+}
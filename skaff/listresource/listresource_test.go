@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package listresource
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestAddToWebsiteDoc(t *testing.T) {
+	const doc = "Currently these are:\n\n* `aws_iam_role`\n* `aws_lb`\n\n## Example Usage\n\n* `aws_zzz` is not a supported type\n"
+
+	testCases := []struct {
+		TestName string
+		TypeName string
+		Expected string
+	}{
+		{
+			TestName: "first",
+			TypeName: "aws_acm_certificate",
+			Expected: "Currently these are:\n\n* `aws_acm_certificate`\n* `aws_iam_role`\n* `aws_lb`\n\n## Example Usage\n\n* `aws_zzz` is not a supported type\n",
+		},
+		{
+			TestName: "middle",
+			TypeName: "aws_kms_key",
+			Expected: "Currently these are:\n\n* `aws_iam_role`\n* `aws_kms_key`\n* `aws_lb`\n\n## Example Usage\n\n* `aws_zzz` is not a supported type\n",
+		},
+		{
+			TestName: "last",
+			TypeName: "aws_ssm_document",
+			Expected: "Currently these are:\n\n* `aws_iam_role`\n* `aws_lb`\n* `aws_ssm_document`\n\n## Example Usage\n\n* `aws_zzz` is not a supported type\n",
+		},
+		{
+			TestName: "existing",
+			TypeName: "aws_lb",
+			Expected: doc,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			filename := filepath.Join(t.TempDir(), "resource_list.html.markdown")
+			if err := os.WriteFile(filename, []byte(doc), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := addToWebsiteDoc(filename, testCase.TypeName); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, err := os.ReadFile(filename)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(got) != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
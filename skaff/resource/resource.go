@@ -4,7 +4,6 @@
 package resource
 
 import (
-	"bytes"
 	_ "embed"
 	"errors"
 	"fmt"
@@ -12,11 +11,12 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
-	"text/template"
 
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/names/data"
+	"github.com/hashicorp/terraform-provider-aws/skaff/apimodel"
 	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+	"github.com/hashicorp/terraform-provider-aws/skaff/internal/generate"
 )
 
 //go:embed resource.gtpl
@@ -31,6 +31,18 @@ var resourceTestTmpl string
 //go:embed websitedoc.gtpl
 var websiteTmpl string
 
+//go:embed resourcefwmodel.gtpl
+var resourceFrameworkModelTmpl string
+
+//go:embed resourcetestmodel.gtpl
+var resourceTestModelTmpl string
+
+//go:embed sweepmodel.gtpl
+var sweepModelTmpl string
+
+//go:embed exportsmodel.gtpl
+var exportsModelTmpl string
+
 type TemplateData struct {
 	Resource             string
 	ResourceLower        string
@@ -46,9 +58,13 @@ type TemplateData struct {
 	PluginFramework      bool
 	HumanResourceName    string
 	ProviderResourceName string
+	Model                *apimodel.Resource // Set if generating from the AWS SDK for Go v2 API model.
 }
 
-func Create(resName, snakeName string, comments, force, pluginFramework, tags bool) error {
+// Create generates scaffolding for a resource.
+// If the create, read and delete operations are specified, a Plugin Framework resource is generated from the
+// AWS SDK for Go v2 API model of those operations.
+func Create(resName, snakeName string, comments, force, pluginFramework, tags bool, ops apimodel.Operations) error {
 	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
 	if err != nil {
 		return fmt.Errorf("error reading working directory: %s", err)
@@ -94,6 +110,25 @@ func Create(resName, snakeName string, comments, force, pluginFramework, tags bo
 		ProviderResourceName: convert.ToProviderResourceName(servicePackage, snakeName),
 	}
 
+	if ops != (apimodel.Operations{}) {
+		if !pluginFramework {
+			return fmt.Errorf("error checking: generating from the AWS API model is only supported for Terraform Plugin Framework")
+		}
+
+		model, err := apimodel.Load(templateData.SDKPackage)
+		if err != nil {
+			return fmt.Errorf("error loading AWS API model: %w", err)
+		}
+
+		templateData.Model, err = model.Resource(resName, ops)
+		if err != nil {
+			return fmt.Errorf("error generating resource from AWS API model: %w", err)
+		}
+		templateData.IncludeTags = templateData.Model.HasTags
+
+		return createFromModel(servicePackage, snakeName, force, templateData)
+	}
+
 	tmpl := resourceTmpl
 	if pluginFramework {
 		tmpl = resourceFrameworkTmpl
@@ -117,41 +152,50 @@ func Create(resName, snakeName string, comments, force, pluginFramework, tags bo
 	return nil
 }
 
-func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
-	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
-		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+func createFromModel(servicePackage, snakeName string, force bool, templateData TemplateData) error {
+	f := fmt.Sprintf("%s.go", snakeName)
+	if err := writeTemplate("newres", f, resourceFrameworkModelTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource template: %w", err)
 	}
 
-	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
-	if err != nil {
-		return fmt.Errorf("error opening file (%s): %s", filename, err)
+	tf := fmt.Sprintf("%s_test.go", snakeName)
+	if err := writeTemplate("restest", tf, resourceTestModelTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource test template: %w", err)
 	}
 
-	tplate, err := template.New(templateName).Parse(tmpl)
-	if err != nil {
-		return fmt.Errorf("error parsing template: %s", err)
+	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, snakeName)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
+	if err := writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource website doc template: %w", err)
 	}
 
-	var buffer bytes.Buffer
-	err = tplate.Execute(&buffer, td)
-	if err != nil {
-		return fmt.Errorf("error executing template: %s", err)
+	// Don't overwrite existing files that hold other resources' declarations.
+	if _, err := os.Stat("exports_test.go"); errors.Is(err, fs.ErrNotExist) {
+		if err := writeTemplate("exports", "exports_test.go", exportsModelTmpl, false, templateData); err != nil {
+			return fmt.Errorf("writing exports template: %w", err)
+		}
+	} else {
+		fmt.Printf("Add the following to exports_test.go:\n\tResource%[1]s = newResource%[1]s\n\tFind%[1]sByID = find%[1]sByID\n", templateData.Resource)
 	}
 
-	//contents, err := format.Source(buffer.Bytes())
-	//if err != nil {
-	//	return fmt.Errorf("error formatting generated file: %s", err)
-	//}
-
-	//if _, err := f.Write(contents); err != nil {
-	if _, err := f.Write(buffer.Bytes()); err != nil {
-		f.Close() // ignore error; Write error takes precedence
-		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	if m := templateData.Model; m.ListOutputMember != "" && m.ListSummaryMember != "" {
+		if _, err := os.Stat("sweep.go"); errors.Is(err, fs.ErrNotExist) {
+			if err := writeTemplate("sweep", "sweep.go", sweepModelTmpl, false, templateData); err != nil {
+				return fmt.Errorf("writing sweeper template: %w", err)
+			}
+		} else {
+			fmt.Printf("Add a sweeper for %s to sweep.go using the %s operation\n", templateData.ProviderResourceName, m.Operations.List)
+		}
 	}
 
-	if err := f.Close(); err != nil {
-		return fmt.Errorf("error closing file (%s): %s", filename, err)
+	if len(templateData.Model.Skipped) > 0 {
+		fmt.Printf("The following AWS members could not be mapped and must be added by hand: %s\n", strings.Join(templateData.Model.Skipped, ", "))
 	}
 
 	return nil
 }
+
+func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
+	// Code generated from the AWS API model is complete enough to be formatted.
+	return generate.WriteTemplate(templateName, filename, tmpl, force, td, td.Model != nil && filepath.Ext(filename) == ".go")
+}
@@ -8,27 +8,34 @@ import (
 	_ "embed"
 	"flag"
 	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
 	"io"
 	"os"
 	"path"
-	"sort"
+	"path/filepath"
+	"reflect"
+	"runtime"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/naming"
 	"golang.org/x/exp/slices"
 )
 
 var (
-	dataSourceType = flag.String("data-source", "", "Data Source type")
-	resourceType   = flag.String("resource", "", "Resource type")
+	dataSourceType  = flag.String("data-source", "", "Data Source type")
+	providerVersion = flag.String("provider-version", "", "Last Plugin SDK V2 provider release, used by the generated migration acceptance test")
+	resourceType    = flag.String("resource", "", "Resource type")
 )
 
 func usage() {
 	fmt.Fprintf(os.Stderr, "Usage:\n")
-	fmt.Fprintf(os.Stderr, "\ttfsdk2fw [-resource <resource-type>|-data-source <data-source-type>] <package-name> <name> <generated-file>\n\n")
+	fmt.Fprintf(os.Stderr, "\ttfsdk2fw [-resource <resource-type>|-data-source <data-source-type>] [-provider-version <version>] <package-name> <name> <generated-file>\n\n")
 }
 
 func main() {
@@ -53,9 +60,10 @@ func main() {
 	// }
 	g := common.NewGenerator()
 	migrator := &migrator{
-		Generator:   g,
-		Name:        name,
-		PackageName: packageName,
+		Generator:       g,
+		Name:            name,
+		PackageName:     packageName,
+		ProviderVersion: *providerVersion,
 	}
 
 	p, err := provider.New(context.Background())
@@ -84,6 +92,7 @@ func main() {
 
 		migrator.Resource = resource
 		migrator.Template = resourceImpl
+		migrator.TestTemplate = resourceTestImpl
 		migrator.TFTypeName = v
 	}
 
@@ -93,16 +102,20 @@ func main() {
 }
 
 type migrator struct {
-	Generator    *common.Generator
-	IsDataSource bool
-	Name         string
-	PackageName  string
-	Resource     *schema.Resource
-	Template     string
-	TFTypeName   string
+	Generator       *common.Generator
+	IsDataSource    bool
+	Name            string
+	PackageName     string
+	ProviderVersion string
+	Resource        *schema.Resource
+	Template        string
+	TestTemplate    string
+	TFTypeName      string
 }
 
 // migrate generates an identical schema into the specified output file.
+// For resources, a CRUD skeleton calling any existing finder and waiter functions is generated,
+// together with an acceptance test verifying state compatibility into a "_migrate_test.go" file alongside the output file.
 func (m *migrator) migrate(outputFilename string) error {
 	m.infof("generating into %[1]q", outputFilename)
 
@@ -114,7 +127,13 @@ func (m *migrator) migrate(outputFilename string) error {
 		return fmt.Errorf("creating target directory %s: %w", dirname, err)
 	}
 
-	templateData, err := m.generateTemplateData()
+	funcs, err := packageFuncs(dirname)
+
+	if err != nil {
+		return err
+	}
+
+	templateData, err := m.generateTemplateData(funcs)
 
 	if err != nil {
 		return err
@@ -126,10 +145,27 @@ func (m *migrator) migrate(outputFilename string) error {
 		return err
 	}
 
+	if err := d.Write(); err != nil {
+		return err
+	}
+
+	if m.TestTemplate == "" {
+		return nil
+	}
+
+	testFilename := strings.TrimSuffix(outputFilename, ".go") + "_migrate_test.go"
+	m.infof("generating into %[1]q", testFilename)
+
+	d = m.Generator.NewGoFileDestination(testFilename)
+
+	if err := d.BufferTemplate("test", m.TestTemplate, templateData); err != nil {
+		return err
+	}
+
 	return d.Write()
 }
 
-func (m *migrator) generateTemplateData() (*templateData, error) {
+func (m *migrator) generateTemplateData(funcs map[string]bool) (*templateData, error) {
 	sbSchema := strings.Builder{}
 	sbStruct := strings.Builder{}
 	emitter := &emitter{
@@ -151,6 +187,7 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		DefaultUpdateTimeout:         emitter.DefaultUpdateTimeout,
 		DefaultDeleteTimeout:         emitter.DefaultDeleteTimeout,
 		EmitResourceImportState:      m.Resource.Importer != nil,
+		ExternalProviderVersion:      m.ProviderVersion,
 		EmitResourceModifyPlan:       !m.IsDataSource && emitter.HasTopLevelTagsAllMap && emitter.HasTopLevelTagsMap,
 		EmitResourceUpdateSkeleton:   m.Resource.Update != nil || m.Resource.UpdateContext != nil || m.Resource.UpdateWithoutTimeout != nil,
 		HasTimeouts:                  emitter.HasTimeouts,
@@ -163,6 +200,12 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		TFTypeName:                   m.TFTypeName,
 	}
 
+	if !m.IsDataSource {
+		if err := m.generateCRUDTemplateData(templateData, funcs); err != nil {
+			return nil, err
+		}
+	}
+
 	for _, v := range emitter.FrameworkPlanModifierPackages {
 		if !slices.Contains(templateData.FrameworkPlanModifierPackages, v) {
 			templateData.FrameworkPlanModifierPackages = append(templateData.FrameworkPlanModifierPackages, v)
@@ -182,10 +225,147 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 	return templateData, nil
 }
 
+// generateCRUDTemplateData populates the template data used to generate a resource's CRUD and state upgrade skeleton.
+// Finder and waiter functions are referenced only if they are declared in the service package.
+func (m *migrator) generateCRUDTemplateData(templateData *templateData, funcs map[string]bool) error {
+	clientName, err := names.ProviderNameUpper(m.PackageName)
+
+	if err != nil {
+		return err
+	}
+
+	humanFriendly, err := names.HumanFriendly(m.PackageName)
+
+	if err != nil {
+		return err
+	}
+
+	templateData.ClientName = clientName
+	templateData.HumanFriendlyName = humanFriendly + " " + naming.ToWords(m.Name)
+
+	lookup := func(format string) string {
+		for _, v := range []string{format, strings.ToUpper(format[:1]) + format[1:]} {
+			if name := fmt.Sprintf(v, m.Name); funcs[name] {
+				return name
+			}
+		}
+
+		return ""
+	}
+
+	templateData.FinderFunc = lookup("find%sByID")
+	if templateData.FinderFunc == "" {
+		m.warnf("no finder function found for %s", m.Name)
+	}
+	if templateData.DefaultCreateTimeout > 0 {
+		templateData.CreateWaiterFunc = lookup("wait%sCreated")
+	}
+	if templateData.DefaultUpdateTimeout > 0 {
+		templateData.UpdateWaiterFunc = lookup("wait%sUpdated")
+	}
+	if templateData.DefaultDeleteTimeout > 0 {
+		templateData.DeleteWaiterFunc = lookup("wait%sDeleted")
+	}
+
+	// Each Plugin Framework state upgrader upgrades directly to the current schema version
+	// by applying the remaining Plugin SDK V2 state upgrade functions in order.
+	upgraders := slices.Clone(m.Resource.StateUpgraders)
+	slices.SortFunc(upgraders, func(a, b schema.StateUpgrader) int {
+		return a.Version - b.Version
+	})
+
+	for i, v := range upgraders {
+		upgrader := stateUpgrader{
+			Version: v.Version,
+		}
+
+		for _, u := range upgraders[i:] {
+			name := stateUpgradeFuncName(m.PackageName, u.Upgrade)
+
+			if name == "" {
+				name = fmt.Sprintf("upgrade%sStateV%d", m.Name, u.Version)
+				m.warnf("Plugin SDK V2 state upgrade function for version %d is not a named function in package %s, referencing %s", u.Version, m.PackageName, name)
+			}
+
+			upgrader.Funcs = append(upgrader.Funcs, name)
+		}
+
+		templateData.StateUpgraders = append(templateData.StateUpgraders, upgrader)
+	}
+
+	if m.Resource.MigrateState != nil {
+		m.warnf("legacy Plugin SDK V2 MigrateState function is not migrated")
+	}
+
+	return nil
+}
+
 func (m *migrator) infof(format string, a ...interface{}) {
 	m.Generator.Infof(format, a...)
 }
 
+func (m *migrator) warnf(format string, a ...interface{}) {
+	m.Generator.Warnf(format, a...)
+}
+
+// packageFuncs returns the names of the top-level functions declared in the non-test Go files in the specified directory.
+func packageFuncs(dirname string) (map[string]bool, error) {
+	funcs := make(map[string]bool)
+
+	filenames, err := filepath.Glob(filepath.Join(dirname, "*.go"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	for _, filename := range filenames {
+		if strings.HasSuffix(filename, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filename, nil, parser.SkipObjectResolution)
+
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", filename, err)
+		}
+
+		for _, decl := range file.Decls {
+			if decl, ok := decl.(*ast.FuncDecl); ok && decl.Recv == nil {
+				funcs[decl.Name.Name] = true
+			}
+		}
+	}
+
+	return funcs, nil
+}
+
+// stateUpgradeFuncName returns the name of the specified Plugin SDK V2 state upgrade function
+// if it is a named function declared in the specified package.
+func stateUpgradeFuncName(packageName string, f schema.StateUpgradeFunc) string {
+	if f == nil {
+		return ""
+	}
+
+	fn := runtime.FuncForPC(reflect.ValueOf(f).Pointer())
+
+	if fn == nil {
+		return ""
+	}
+
+	// e.g. "github.com/hashicorp/terraform-provider-aws/internal/service/ec2.instanceStateUpgradeV0".
+	name := fn.Name()
+	name = name[strings.LastIndex(name, "/")+1:]
+	pkg, name, ok := strings.Cut(name, ".")
+
+	if !ok || pkg != packageName || strings.Contains(name, ".") {
+		// Not in the package, or an anonymous function (e.g. "resourceInstance.func1").
+		return ""
+	}
+
+	return name
+}
+
 type emitter struct {
 	DefaultCreateTimeout          int64
 	DefaultReadTimeout            int64
@@ -901,24 +1081,37 @@ func unsupportedTypeError(path []string, typ string) error {
 }
 
 type templateData struct {
+	ClientName                    string // e.g. EC2
+	CreateWaiterFunc              string // e.g. waitInstanceCreated
 	DefaultCreateTimeout          int64
 	DefaultReadTimeout            int64
 	DefaultUpdateTimeout          int64
 	DefaultDeleteTimeout          int64
+	DeleteWaiterFunc              string // e.g. waitInstanceDeleted
 	EmitResourceImportState       bool
 	EmitResourceModifyPlan        bool
 	EmitResourceUpdateSkeleton    bool
+	ExternalProviderVersion       string // e.g. 5.70.0
+	FinderFunc                    string // e.g. findInstanceByID
 	FrameworkPlanModifierPackages []string
 	FrameworkValidatorsPackages   []string
 	GoImports                     []goImport
 	HasTimeouts                   bool
+	HumanFriendlyName             string // e.g. EC2 Instance
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
 	Name                          string // e.g. Instance
 	PackageName                   string // e.g. ec2
 	Schema                        string
+	StateUpgraders                []stateUpgrader
 	Struct                        string
 	TFTypeName                    string // e.g. aws_instance
+	UpdateWaiterFunc              string // e.g. waitInstanceUpdated
+}
+
+type stateUpgrader struct {
+	Funcs   []string // Plugin SDK V2 state upgrade functions, in order.
+	Version int      // Prior schema version.
 }
 
 //go:embed datasource.gtpl
@@ -927,6 +1120,9 @@ var datasourceImpl string
 //go:embed resource.gtpl
 var resourceImpl string
 
+//go:embed resource_test.gtpl
+var resourceTestImpl string
+
 type goImport struct {
 	Path  string
 	Alias string
@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package naming
+
+import (
+	"strings"
+)
+
+// ToWords splits a CamelCase string into space-separated words.
+// Runs of capital letters are treated as a single word (e.g. "VPCEndpoint" becomes "VPC Endpoint").
+func ToWords(s string) string {
+	c := strings.Builder{}
+
+	s = strings.TrimSpace(s)
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+
+		if i > 0 && isCapitalLetter(ch) {
+			prev := s[i-1]
+
+			if isLowercaseLetter(prev) || isNumeric(prev) || (isCapitalLetter(prev) && i+1 < len(s) && isLowercaseLetter(s[i+1])) {
+				c.WriteByte(' ')
+			}
+		}
+
+		c.WriteByte(ch)
+	}
+
+	return c.String()
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package naming_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/naming"
+)
+
+func TestToWords(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		Value         string
+		ExpectedValue string
+	}{
+		{
+			TestName:      "empty string",
+			Value:         "",
+			ExpectedValue: "",
+		},
+		{
+			TestName:      "single word",
+			Value:         "Instance",
+			ExpectedValue: "Instance",
+		},
+		{
+			TestName:      "multiple words",
+			Value:         "JobQueue",
+			ExpectedValue: "Job Queue",
+		},
+		{
+			TestName:      "leading initialism",
+			Value:         "VPCEndpoint",
+			ExpectedValue: "VPC Endpoint",
+		},
+		{
+			TestName:      "trailing initialism",
+			Value:         "EndpointVPC",
+			ExpectedValue: "Endpoint VPC",
+		},
+		{
+			TestName:      "digits",
+			Value:         "S3Bucket",
+			ExpectedValue: "S3 Bucket",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := naming.ToWords(testCase.Value)
+
+			if got != testCase.ExpectedValue {
+				t.Errorf("expected: %s, got: %s", testCase.ExpectedValue, got)
+			}
+		})
+	}
+}